@@ -0,0 +1,3 @@
+data "nexus_repository_cargo_proxy" "crates_io" {
+  name = "crates-io"
+}
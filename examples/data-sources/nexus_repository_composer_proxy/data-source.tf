@@ -0,0 +1,3 @@
+data "nexus_repository_composer_proxy" "packagist" {
+  name = "packagist"
+}
@@ -0,0 +1,3 @@
+data "nexus_repository_conan_hosted" "hosted" {
+  name = "conan-internal"
+}
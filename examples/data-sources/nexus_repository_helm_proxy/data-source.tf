@@ -1,3 +1,3 @@
-data "nexus_repository_helm_proxy" "kubernetes_charts" {
-  name = "kubernetes-charts"
+data "nexus_repository_helm_proxy" "bitnami" {
+  name = "bitnami"
 }
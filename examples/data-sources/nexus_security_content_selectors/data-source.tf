@@ -0,0 +1,5 @@
+data "nexus_security_content_selectors" "all" {}
+
+locals {
+  content_selector_names = [for selector in data.nexus_security_content_selectors.all.selectors : selector.name]
+}
@@ -0,0 +1,8 @@
+data "nexus_security_ssl_certificate" "ldap" {
+  host = "ldap.example.com"
+  port = 636
+}
+
+resource "nexus_security_ssl_truststore" "ldap" {
+  pem = data.nexus_security_ssl_certificate.ldap.pem
+}
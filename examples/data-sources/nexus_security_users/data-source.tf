@@ -0,0 +1,8 @@
+data "nexus_security_users" "ci" {
+  userid = "ci-"
+  source = "default"
+}
+
+output "ci_userids" {
+  value = [for user in data.nexus_security_users.ci.users : user.userid]
+}
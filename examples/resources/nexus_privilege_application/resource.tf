@@ -0,0 +1,6 @@
+resource "nexus_privilege_application" "users_read" {
+  name        = "users-read"
+  description = "Read access to users"
+  actions     = ["BROWSE", "READ"]
+  domain      = "users"
+}
@@ -0,0 +1,2 @@
+# import using the name of the privilege
+terraform import nexus_privilege_repository_admin.releases_admin releases-admin
@@ -0,0 +1,7 @@
+resource "nexus_privilege_repository_admin" "releases_admin" {
+  name        = "releases-admin"
+  description = "Administration of the releases repository"
+  actions     = ["BROWSE", "READ", "EDIT", "DELETE"]
+  format      = "maven2"
+  repository  = "releases"
+}
@@ -0,0 +1,2 @@
+# import using the name of the privilege
+terraform import nexus_privilege_repository_content_selector.team_alpha_write team-alpha-write
@@ -0,0 +1,13 @@
+resource "nexus_security_content_selector" "team_alpha" {
+  name       = "team-alpha"
+  expression = "format == \"maven2\" and path =^ \"/com/example/alpha/\""
+}
+
+resource "nexus_privilege_repository_content_selector" "team_alpha_write" {
+  name             = "team-alpha-write"
+  description      = "Write access to the artifacts of team alpha"
+  actions          = ["BROWSE", "READ", "EDIT", "ADD"]
+  content_selector = nexus_security_content_selector.team_alpha.name
+  format           = "maven2"
+  repository       = "releases"
+}
@@ -0,0 +1,2 @@
+# import using the name of the privilege
+terraform import 'nexus_privilege_repository_view.team_read["alpha"]' alpha-maven-read
@@ -0,0 +1,13 @@
+locals {
+  teams = ["alpha", "beta"]
+}
+
+resource "nexus_privilege_repository_view" "team_read" {
+  for_each = toset(local.teams)
+
+  name        = "${each.value}-maven-read"
+  description = "Read access to the maven repository of team ${each.value}"
+  actions     = ["BROWSE", "READ"]
+  format      = "maven2"
+  repository  = "${each.value}-releases"
+}
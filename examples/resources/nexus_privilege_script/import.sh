@@ -0,0 +1,2 @@
+# import using the name of the privilege
+terraform import nexus_privilege_script.cleanup_run cleanup-run
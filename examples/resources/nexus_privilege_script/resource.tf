@@ -0,0 +1,12 @@
+resource "nexus_script" "cleanup" {
+  name    = "cleanup"
+  type    = "groovy"
+  content = "log.info('cleanup')"
+}
+
+resource "nexus_privilege_script" "cleanup_run" {
+  name        = "cleanup-run"
+  description = "Allows CI users to run the cleanup script"
+  actions     = ["BROWSE", "READ", "RUN"]
+  script_name = nexus_script.cleanup.name
+}
@@ -0,0 +1,5 @@
+resource "nexus_privilege_wildcard" "maven_read" {
+  name        = "maven-read"
+  description = "Read access to all maven repositories"
+  pattern     = "nexus:repository-view:maven2:*:browse,read"
+}
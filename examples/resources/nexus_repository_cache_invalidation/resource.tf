@@ -0,0 +1,26 @@
+resource "nexus_repository_npm_proxy" "npmjs" {
+  name   = "npmjs"
+  online = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy {
+    remote_url = "https://registry.npmjs.org"
+  }
+
+  http_client {
+    blocked    = false
+    auto_block = true
+  }
+}
+
+# Flush the cache whenever the remote URL changes
+resource "nexus_repository_cache_invalidation" "npmjs" {
+  repository = nexus_repository_npm_proxy.npmjs.name
+  triggers = {
+    remote_url = nexus_repository_npm_proxy.npmjs.proxy[0].remote_url
+  }
+}
@@ -8,7 +8,7 @@ resource "nexus_repository_cocoapods_proxy" "cocoapods_org" {
   }
 
   proxy {
-    remote_url       = "https://cocoapods.org/"
+    remote_url       = "https://cdn.cocoapods.org/"
     content_max_age  = 1440
     metadata_max_age = 1440
   }
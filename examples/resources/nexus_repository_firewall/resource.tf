@@ -0,0 +1,24 @@
+resource "nexus_repository_npm_proxy" "npmjs" {
+  name   = "npmjs"
+  online = true
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy {
+    remote_url = "https://registry.npmjs.org"
+  }
+
+  http_client {
+    blocked    = false
+    auto_block = true
+  }
+}
+
+resource "nexus_repository_firewall" "npmjs" {
+  repository    = nexus_repository_npm_proxy.npmjs.name
+  audit_enabled = true
+  quarantine    = true
+}
@@ -0,0 +1,2 @@
+# import using the name of the group repository, all current members are adopted
+terraform import nexus_repository_group_membership.team_releases maven-public
@@ -0,0 +1,21 @@
+resource "nexus_repository_maven_hosted" "team_releases" {
+  name   = "team-releases"
+  online = true
+
+  maven {
+    version_policy = "RELEASE"
+    layout_policy  = "STRICT"
+  }
+
+  storage {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW_ONCE"
+  }
+}
+
+# Adds the repository to a group which is managed elsewhere
+resource "nexus_repository_group_membership" "team_releases" {
+  repository   = "maven-public"
+  member_names = [nexus_repository_maven_hosted.team_releases.name]
+}
@@ -0,0 +1,2 @@
+# import using the name of the proxy repository
+terraform import 'nexus_repository_health_check.proxies["npmjs"]' npmjs
@@ -0,0 +1,8 @@
+data "nexus_repository_list" "all" {}
+
+# Enable Repository Health Check on every proxy repository
+resource "nexus_repository_health_check" "proxies" {
+  for_each = toset([for r in data.nexus_repository_list.all.items : r.name if r.type == "proxy"])
+
+  repository = each.value
+}
@@ -1,2 +1,2 @@
 # import using the name of repository
-terraform import nexus_repository_helm_proxy.kubernetes_charts kubernetes-charts
+terraform import nexus_repository_helm_proxy.bitnami bitnami
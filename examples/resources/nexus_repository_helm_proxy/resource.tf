@@ -1,5 +1,5 @@
-resource "nexus_repository_helm_proxy" "kubernetes_charts" {
-  name   = "kubernetes-charts"
+resource "nexus_repository_helm_proxy" "bitnami" {
+  name   = "bitnami"
   online = true
 
   storage {
@@ -8,7 +8,7 @@ resource "nexus_repository_helm_proxy" "kubernetes_charts" {
   }
 
   proxy {
-    remote_url       = "https://kubernetes-charts.storage.googleapis.com/"
+    remote_url       = "https://charts.bitnami.com/bitnami"
     content_max_age  = 1440
     metadata_max_age = 1440
   }
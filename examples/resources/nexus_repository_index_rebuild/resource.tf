@@ -0,0 +1,28 @@
+resource "nexus_blobstore_file" "releases" {
+  name = "releases"
+  path = "/nexus-data/releases"
+}
+
+resource "nexus_repository_maven_hosted" "releases" {
+  name   = "releases"
+  online = true
+
+  maven {
+    version_policy = "RELEASE"
+    layout_policy  = "STRICT"
+  }
+
+  storage {
+    blob_store_name                = nexus_blobstore_file.releases.id
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW_ONCE"
+  }
+}
+
+# Rebuild the index whenever the repository is moved to another blob store
+resource "nexus_repository_index_rebuild" "releases" {
+  repository = nexus_repository_maven_hosted.releases.name
+  triggers = {
+    blob_store_name = nexus_repository_maven_hosted.releases.storage[0].blob_store_name
+  }
+}
@@ -0,0 +1,17 @@
+provider "nexus" {
+  url      = "http://127.0.0.1:8081"
+  username = "admin"
+  password = var.admin_password
+}
+
+resource "nexus_security_admin_password" "admin" {
+  initial_password_file = "/nexus-data/admin.password"
+  password              = var.admin_password
+  complete_onboarding   = true
+}
+
+resource "nexus_security_anonymous" "system" {
+  enabled = false
+
+  depends_on = [nexus_security_admin_password.admin]
+}
@@ -0,0 +1,2 @@
+# import of the crowd settings
+terraform import nexus_security_crowd.nexus crowd
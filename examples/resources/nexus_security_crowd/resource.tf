@@ -0,0 +1,10 @@
+resource "nexus_security_crowd" "nexus" {
+  application_name     = "nexus"
+  application_password = var.crowd_application_password
+  server_url           = "https://crowd.example.com/crowd"
+  timeout              = 30
+}
+
+resource "nexus_security_realm_activation" "crowd" {
+  realm = "Crowd"
+}
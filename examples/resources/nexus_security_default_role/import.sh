@@ -0,0 +1,2 @@
+# import the default role capability using its capability id
+terraform import nexus_security_default_role.authenticated 4e8f1c2a7b3d9e60
@@ -0,0 +1,16 @@
+resource "nexus_security_role" "authenticated" {
+  roleid = "authenticated"
+  name   = "authenticated"
+  privileges = [
+    "nx-repository-view-*-*-browse",
+    "nx-repository-view-*-*-read",
+  ]
+}
+
+resource "nexus_security_default_role" "authenticated" {
+  role = nexus_security_role.authenticated.roleid
+}
+
+resource "nexus_security_realm_activation" "default_role" {
+  realm = "DefaultRole"
+}
@@ -0,0 +1,2 @@
+# import using <source>/<external_group>
+terraform import nexus_security_external_role_mapping.developers LDAP/developers
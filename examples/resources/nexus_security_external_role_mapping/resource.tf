@@ -0,0 +1,13 @@
+resource "nexus_security_realm_activation" "ldap" {
+  realm = "LdapRealm"
+}
+
+resource "nexus_security_external_role_mapping" "developers" {
+  source         = "LDAP"
+  external_group = "developers"
+  description    = "Developers of the LDAP directory"
+  privileges     = ["nx-repository-view-*-*-read"]
+  roles          = ["nx-anonymous"]
+
+  depends_on = [nexus_security_realm_activation.ldap]
+}
@@ -0,0 +1,2 @@
+# import an activated security realm
+terraform import nexus_security_realm_activation.docker DockerToken
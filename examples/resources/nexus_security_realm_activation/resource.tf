@@ -0,0 +1,3 @@
+resource "nexus_security_realm_activation" "docker" {
+  realm = "DockerToken"
+}
@@ -0,0 +1,2 @@
+# import all privileges of a role using its roleid
+terraform import nexus_security_role_privileges.developers developers
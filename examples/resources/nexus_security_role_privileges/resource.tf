@@ -0,0 +1,7 @@
+resource "nexus_security_role_privileges" "developers" {
+  roleid = "developers"
+  privileges = [
+    "nx-repository-view-docker-docker-hosted-browse",
+    "nx-repository-view-docker-docker-hosted-read",
+  ]
+}
@@ -0,0 +1,2 @@
+# import a truststore certificate by its id
+terraform import nexus_security_ssl_truststore.ldap 59:C4:F1:6C:2B:AD:1F:DE:B0:A4:8A:F4:D4:B9:67:FA:E8:6E:2C:5F
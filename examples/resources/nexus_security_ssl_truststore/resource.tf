@@ -0,0 +1,3 @@
+resource "nexus_security_ssl_truststore" "ldap" {
+  pem = file("${path.module}/ldap.example.com.pem")
+}
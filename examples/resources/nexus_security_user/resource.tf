@@ -7,3 +7,15 @@ resource "nexus_security_user" "admin" {
   roles     = ["nx-admin"]
   status    = "active"
 }
+
+# Keep the password out of the state, bump password_version to rotate it
+resource "nexus_security_user" "deployer" {
+  userid           = "deployer"
+  firstname        = "Deployment"
+  lastname         = "User"
+  email            = "deployer@example.com"
+  password_wo      = var.deployer_password
+  password_version = 1
+  roles            = ["nx-anonymous"]
+  status           = "active"
+}
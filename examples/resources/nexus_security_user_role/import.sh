@@ -0,0 +1,5 @@
+# import all roles of a user of the default source using its userid
+terraform import nexus_security_user_role.deployer deployer
+
+# import all roles of a user of another source using <source>/<userid>
+terraform import nexus_security_user_role.ldap LDAP/jdoe
@@ -0,0 +1,18 @@
+resource "nexus_security_user_role" "deployer" {
+  userid = "deployer"
+  roles  = ["nx-deployment"]
+}
+
+# Remove all roles of the user which are not managed by Terraform
+resource "nexus_security_user_role" "auditor" {
+  userid = "auditor"
+  roles  = ["nx-audit"]
+  mode   = "authoritative"
+}
+
+# Map roles to a user of the LDAP realm
+resource "nexus_security_user_role" "ldap" {
+  userid = "jdoe"
+  source = "LDAP"
+  roles  = ["nx-deployment"]
+}
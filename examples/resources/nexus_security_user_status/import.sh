@@ -0,0 +1,5 @@
+# import the status of a user of the default source using its userid
+terraform import nexus_security_user_status.offboarded jdoe
+
+# import the status of a user of another source using <source>/<userid>
+terraform import nexus_security_user_status.offboarded LDAP/jdoe
@@ -0,0 +1,4 @@
+resource "nexus_security_user_status" "offboarded" {
+  userid = "jdoe"
+  status = "disabled"
+}
@@ -0,0 +1,18 @@
+package acceptance
+
+const (
+	TemplateStringRepositoryCargoGroup = `
+resource "nexus_repository_cargo_group" "acceptance" {
+	depends_on = [
+		nexus_repository_cargo_hosted.acceptance
+	]
+` + TemplateStringGroupRepository
+
+	TemplateStringRepositoryCargoHosted = `
+resource "nexus_repository_cargo_hosted" "acceptance" {
+` + TemplateStringHostedRepository
+
+	TemplateStringRepositoryCargoProxy = `
+resource "nexus_repository_cargo_proxy" "acceptance" {
+` + TemplateStringProxyRepository
+)
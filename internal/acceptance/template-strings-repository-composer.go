@@ -0,0 +1,7 @@
+package acceptance
+
+const (
+	TemplateStringRepositoryComposerProxy = `
+resource "nexus_repository_composer_proxy" "acceptance" {
+` + TemplateStringProxyRepository
+)
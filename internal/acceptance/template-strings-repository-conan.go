@@ -1,7 +1,14 @@
 package acceptance
 
 const (
+	TemplateStringRepositoryConanHosted = `
+resource "nexus_repository_conan_hosted" "acceptance" {
+` + TemplateStringHostedRepository
+
 	TemplateStringRepositoryConanProxy = `
 resource "nexus_repository_conan_proxy" "acceptance" {
+	{{- if .ConanProxy }}
+	protocol_version = "{{ .ConanProxy.ConanVersion }}"
+	{{- end }}
 ` + TemplateStringProxyRepository
 )
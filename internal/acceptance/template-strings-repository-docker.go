@@ -10,10 +10,17 @@ resource "nexus_repository_docker_hosted" "acceptance" {
 {{- end }}
 {{- if .Docker.HTTPSPort }}
 		https_port = "{{ .Docker.HTTPSPort }}"
+{{- end }}
+{{- if .Docker.Subdomain }}
+		subdomain = "{{ .Docker.Subdomain }}"
 {{- end }}
 		v1_enabled = "{{ .Docker.V1Enabled }}"
 	}
-` + TemplateStringHostedRepository
+` + TemplateStringNameOnline +
+		TemplateStringCleanup +
+		TemplateStringComponent +
+		TemplateStringStorageDockerHosted +
+		TemplateStringEnd
 
 	TemplateStringRepositoryDockerGroup = `
 resource "nexus_repository_docker_group" "acceptance" {
@@ -24,6 +31,9 @@ resource "nexus_repository_docker_group" "acceptance" {
 {{- end }}
 {{- if .Docker.HTTPSPort }}
 		https_port = "{{ .Docker.HTTPSPort }}"
+{{- end }}
+{{- if .Docker.Subdomain }}
+		subdomain = "{{ .Docker.Subdomain }}"
 {{- end }}
 		v1_enabled = "{{ .Docker.V1Enabled }}"
 	}
@@ -41,15 +51,41 @@ resource "nexus_repository_docker_proxy" "acceptance" {
 {{- end }}
 {{- if .Docker.HTTPSPort }}
 		https_port = "{{ .Docker.HTTPSPort }}"
+{{- end }}
+{{- if .Docker.Subdomain }}
+		subdomain = "{{ .Docker.Subdomain }}"
 {{- end }}
 		v1_enabled = "{{ .Docker.V1Enabled }}"
 	}
 
 	docker_proxy {
+{{- if .DockerProxy.CacheForeignLayers }}
+		cache_foreign_layers = "{{ .DockerProxy.CacheForeignLayers }}"
+{{- end }}
+{{- if .DockerProxy.ForeignLayerURLWhitelist }}
+		foreign_layer_url_whitelist = [
+		{{- range $val := .DockerProxy.ForeignLayerURLWhitelist }}
+			"{{ $val }}",
+		{{ end -}}
+		]
+{{- end }}
 		index_type = "{{ .DockerProxy.IndexType }}"
 {{- if .DockerProxy.IndexURL }}
 		index_url = "{{ .DockerProxy.IndexURL }}"
 {{- end }}
 	}
 ` + TemplateStringProxyRepository
+
+	TemplateStringStorageDockerHosted = `
+	storage {
+		blob_store_name                = "{{ .Storage.BlobStoreName }}"
+		strict_content_type_validation = {{ .Storage.StrictContentTypeValidation }}
+		{{- if .Storage.WritePolicy }}
+		write_policy                   = "{{ .Storage.WritePolicy }}"
+		{{- end }}
+		{{- if .Storage.LatestPolicy }}
+		latest_policy                  = {{ .Storage.LatestPolicy }}
+		{{- end }}
+	}
+`
 )
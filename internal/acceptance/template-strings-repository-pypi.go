@@ -14,5 +14,6 @@ resource "nexus_repository_pypi_group" "acceptance" {
 
 	TemplateStringRepositoryPypiProxy = `
 resource "nexus_repository_pypi_proxy" "acceptance" {
+	remove_quarantined = {{ .Pypi.RemoveQuarantined }}
 ` + TemplateStringProxyRepository
 )
@@ -3,6 +3,9 @@ package acceptance
 const (
 	TemplateStringRepositoryRawHosted = `
 resource "nexus_repository_raw_hosted" "acceptance" {
+	{{- if .Raw }}
+	content_disposition = "{{ .Raw.ContentDisposition }}"
+	{{- end }}
 ` + TemplateStringHostedRepository
 
 	TemplateStringRepositoryRawGroup = `
@@ -10,9 +13,15 @@ resource "nexus_repository_raw_group" "acceptance" {
 	depends_on = [
 		nexus_repository_raw_hosted.acceptance
 	]
+	{{- if .Raw }}
+	content_disposition = "{{ .Raw.ContentDisposition }}"
+	{{- end }}
 ` + TemplateStringGroupRepository
 
 	TemplateStringRepositoryRawProxy = `
 resource "nexus_repository_raw_proxy" "acceptance" {
+	{{- if .Raw }}
+	content_disposition = "{{ .Raw.ContentDisposition }}"
+	{{- end }}
 ` + TemplateStringProxyRepository
 )
@@ -6,6 +6,7 @@ const (
 		TemplateStringHTTPClient +
 		TemplateStringNegativeCache +
 		TemplateStringProxy +
+		TemplateStringReplication +
 		TemplateStringRoutingRule +
 		TemplateStringStorage +
 		TemplateStringEnd
@@ -56,6 +57,9 @@ const (
 
 		{{ if .HTTPClient.Authentication -}}
 		authentication {
+			{{ if .HTTPClient.Authentication.BearerToken -}}
+			bearer_token = "{{ .HTTPClient.Authentication.BearerToken }}"
+			{{ end -}}
 			ntlm_domain = "{{ .HTTPClient.Authentication.NTLMDomain }}"
 			ntlm_host   = "{{ .HTTPClient.Authentication.NTLMHost }}"
 			{{ if .HTTPClient.Authentication.Password -}}
@@ -142,6 +146,17 @@ group {
 	}
 `
 
+	TemplateStringReplication = `
+{{ if .Replication }}
+	replication {
+		{{ if .Replication.AssetPathRegex -}}
+		asset_path_regex        = "{{ deref .Replication.AssetPathRegex }}"
+		{{ end -}}
+		preemptive_pull_enabled = {{ .Replication.PreemptivePullEnabled }}
+	}
+{{ end -}}
+`
+
 	TemplateStringRoutingRule = `
 	{{ if .RoutingRule }}
 		routing_rule = nexus_routing_rule.acceptance.name
@@ -0,0 +1,42 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
+)
+
+const (
+	blobStoreAPIEndpoint = client.BasePath + "v1/blobstores"
+)
+
+type BlobStoreService struct {
+	client *client.Client
+}
+
+func NewBlobStoreService(c *client.Client) *BlobStoreService {
+	return &BlobStoreService{
+		client: c,
+	}
+}
+
+// List returns all blob stores. Unlike go-nexus-client, errors keep the HTTP
+// status code of the response.
+func (s *BlobStoreService) List() ([]blobstore.Generic, error) {
+	body, resp, err := s.client.Get(blobStoreAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp, body, "could not list blob stores")
+	}
+
+	var blobStores []blobstore.Generic
+	if err := json.Unmarshal(body, &blobStores); err != nil {
+		return nil, fmt.Errorf("could not unmarshal blob stores: %v", err)
+	}
+	return blobStores, nil
+}
@@ -0,0 +1,148 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	// Nexus has no REST API for capabilities, they are managed with the
+	// Ext.Direct API of the UI
+	extDirectEndpoint = "service/extdirect"
+
+	capabilityAction = "capability_Capability"
+
+	CapabilityTypeDefaultRole = "defaultrole"
+)
+
+type Capability struct {
+	ID         string            `json:"id,omitempty"`
+	TypeID     string            `json:"typeId"`
+	Notes      string            `json:"notes"`
+	Enabled    bool              `json:"enabled"`
+	Properties map[string]string `json:"properties"`
+}
+
+type extDirectRequest struct {
+	Action string      `json:"action"`
+	Method string      `json:"method"`
+	Data   interface{} `json:"data"`
+	Type   string      `json:"type"`
+	TID    int         `json:"tid"`
+}
+
+type extDirectResponse struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	Result  struct {
+		Success bool              `json:"success"`
+		Message string            `json:"message"`
+		Errors  map[string]string `json:"errors"`
+		Data    json.RawMessage   `json:"data"`
+	} `json:"result"`
+}
+
+type CapabilityService struct {
+	client *client.Client
+}
+
+func NewCapabilityService(c *client.Client) *CapabilityService {
+	return &CapabilityService{
+		client: c,
+	}
+}
+
+// call runs a method of the capability Ext.Direct action and unmarshals the
+// data of the result into result, if given
+func (s *CapabilityService) call(method string, data interface{}, result interface{}) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(extDirectRequest{
+		Action: capabilityAction,
+		Method: method,
+		Data:   data,
+		Type:   "rpc",
+		TID:    1,
+	})
+	if err != nil {
+		return err
+	}
+
+	body, resp, err := s.client.Post(extDirectEndpoint, ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not %s capability: HTTP: %d, %s", method, resp.StatusCode, string(body))
+	}
+
+	var response extDirectResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return fmt.Errorf("could not unmarshal capability response: %v", err)
+	}
+	if response.Type == "exception" {
+		return fmt.Errorf("could not %s capability: %s", method, response.Message)
+	}
+	if !response.Result.Success {
+		message := response.Result.Message
+		fields := make([]string, 0, len(response.Result.Errors))
+		for field := range response.Result.Errors {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		for _, field := range fields {
+			message = fmt.Sprintf("%s %s: %s", message, field, response.Result.Errors[field])
+		}
+		return fmt.Errorf("could not %s capability: %s", method, message)
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(response.Result.Data, result); err != nil {
+		return fmt.Errorf("could not unmarshal capability: %v", err)
+	}
+	return nil
+}
+
+func (s *CapabilityService) List() ([]Capability, error) {
+	var capabilities []Capability
+	if err := s.call("read", nil, &capabilities); err != nil {
+		return nil, err
+	}
+	return capabilities, nil
+}
+
+// Get returns the capability with the given id, nil if it does not exist
+func (s *CapabilityService) Get(id string) (*Capability, error) {
+	capabilities, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, capability := range capabilities {
+		if capability.ID == id {
+			return &capability, nil
+		}
+	}
+	return nil, nil
+}
+
+// Create creates the capability and returns its id
+func (s *CapabilityService) Create(capability Capability) (string, error) {
+	var created Capability
+	if err := s.call("create", []Capability{capability}, &created); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+func (s *CapabilityService) Update(capability Capability) error {
+	return s.call("update", []Capability{capability}, nil)
+}
+
+func (s *CapabilityService) Delete(id string) error {
+	return s.call("remove", []string{id}, nil)
+}
@@ -0,0 +1,45 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	cleanupPolicyAPIEndpoint = client.BasePath + "v1/cleanup-policies"
+)
+
+type CleanupPolicy struct {
+	Name   string `json:"name"`
+	Format string `json:"format"`
+	Notes  string `json:"notes,omitempty"`
+}
+
+type CleanupPolicyService struct {
+	client *client.Client
+}
+
+func NewCleanupPolicyService(c *client.Client) *CleanupPolicyService {
+	return &CleanupPolicyService{
+		client: c,
+	}
+}
+
+func (s *CleanupPolicyService) List() ([]CleanupPolicy, error) {
+	body, resp, err := s.client.Get(cleanupPolicyAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp, body, "could not list cleanup policies")
+	}
+
+	var policies []CleanupPolicy
+	if err := json.Unmarshal(body, &policies); err != nil {
+		return nil, fmt.Errorf("could not unmarshal cleanup policies: %v", err)
+	}
+	return policies, nil
+}
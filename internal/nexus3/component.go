@@ -0,0 +1,59 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	componentAPIEndpoint = client.BasePath + "v1/components"
+)
+
+type Component struct {
+	ID         string `json:"id"`
+	Repository string `json:"repository"`
+	Format     string `json:"format"`
+	Group      string `json:"group"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+}
+
+type componentListResponse struct {
+	Items             []Component `json:"items"`
+	ContinuationToken string      `json:"continuationToken"`
+}
+
+type ComponentService struct {
+	client *client.Client
+}
+
+func NewComponentService(c *client.Client) *ComponentService {
+	return &ComponentService{
+		client: c,
+	}
+}
+
+// HasComponents reports whether the repository contains at least one
+// component. Only the first page of the component list is requested.
+func (s *ComponentService) HasComponents(repositoryName string) (bool, error) {
+	body, resp, err := s.client.Get(fmt.Sprintf("%s?repository=%s", componentAPIEndpoint, url.QueryEscape(repositoryName)), nil)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("could not list components of repository '%s': HTTP: %d, %s", repositoryName, resp.StatusCode, string(body))
+	}
+
+	var components componentListResponse
+	if err := json.Unmarshal(body, &components); err != nil {
+		return false, fmt.Errorf("could not unmarshal components: %v", err)
+	}
+	return len(components.Items) > 0, nil
+}
@@ -0,0 +1,40 @@
+package nexus3
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ResponseError is returned for unexpected HTTP responses, so callers can
+// react to specific status codes
+type ResponseError struct {
+	StatusCode int
+	message    string
+}
+
+func newResponseError(resp *http.Response, body []byte, format string, a ...interface{}) error {
+	return &ResponseError{
+		StatusCode: resp.StatusCode,
+		message:    fmt.Sprintf("%s: HTTP: %d, %s", fmt.Sprintf(format, a...), resp.StatusCode, string(body)),
+	}
+}
+
+func (e *ResponseError) Error() string {
+	return e.message
+}
+
+// HasStatusCode reports whether err is a ResponseError with one of the given
+// status codes
+func HasStatusCode(err error, statusCodes ...int) bool {
+	var responseError *ResponseError
+	if !errors.As(err, &responseError) {
+		return false
+	}
+	for _, statusCode := range statusCodes {
+		if responseError.StatusCode == statusCode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	// Pro-only, requires a configured IQ server connection
+	firewallAuditAPIEndpoint = client.BasePath + "v1/iq/audit"
+)
+
+type FirewallAuditAndQuarantine struct {
+	RepositoryName string `json:"repositoryName"`
+	AuditEnabled   bool   `json:"auditEnabled"`
+	Quarantine     bool   `json:"quarantine"`
+}
+
+type FirewallService struct {
+	client *client.Client
+}
+
+func NewFirewallService(c *client.Client) *FirewallService {
+	return &FirewallService{
+		client: c,
+	}
+}
+
+// Get returns nil if the repository does not exist
+func (s *FirewallService) Get(repositoryName string) (*FirewallAuditAndQuarantine, error) {
+	body, resp, err := s.client.Get(fmt.Sprintf("%s/%s", firewallAuditAPIEndpoint, repositoryName), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read firewall audit and quarantine of repository '%s': HTTP: %d, %s", repositoryName, resp.StatusCode, string(body))
+	}
+
+	var config FirewallAuditAndQuarantine
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("could not unmarshal firewall audit and quarantine: %v", err)
+	}
+	return &config, nil
+}
+
+func (s *FirewallService) Update(config FirewallAuditAndQuarantine) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(config)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Put(firewallAuditAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update firewall audit and quarantine of repository '%s': HTTP: %d, %s", config.RepositoryName, resp.StatusCode, string(body))
+	}
+	return nil
+}
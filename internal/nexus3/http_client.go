@@ -0,0 +1,50 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	HTTPClientAuthenticationTypeBearerToken repository.HTTPClientAuthenticationType = "bearerToken"
+)
+
+// HTTPClient extends repository.HTTPClient with bearer token authentication
+type HTTPClient struct {
+	Authentication *HTTPClientAuthentication        `json:"authentication,omitempty"`
+	AutoBlock      bool                             `json:"autoBlock"`
+	Blocked        bool                             `json:"blocked"`
+	Connection     *repository.HTTPClientConnection `json:"connection,omitempty"`
+}
+
+// HTTPClientWithPreemptiveAuth extends repository.HTTPClientWithPreemptiveAuth with bearer token authentication
+type HTTPClientWithPreemptiveAuth struct {
+	// Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive
+	AutoBlock bool `json:"autoBlock"`
+	// Whether to block outbound connections on the repository
+	Blocked bool `json:"blocked"`
+
+	Authentication *HTTPClientAuthenticationWithPreemptive `json:"authentication,omitempty"`
+	Connection     *repository.HTTPClientConnection        `json:"connection,omitempty"`
+}
+
+// HTTPClientAuthentication extends repository.HTTPClientAuthentication with bearer token authentication
+type HTTPClientAuthentication struct {
+	BearerToken string                                  `json:"bearerToken,omitempty"`
+	NTLMDomain  string                                  `json:"ntlmDomain,omitempty"`
+	NTLMHost    string                                  `json:"ntlmHost,omitempty"`
+	Password    string                                  `json:"password,omitempty"`
+	Type        repository.HTTPClientAuthenticationType `json:"type"`
+	Username    string                                  `json:"username,omitempty"`
+}
+
+// HTTPClientAuthenticationWithPreemptive extends repository.HTTPClientAuthenticationWithPreemptive with bearer token authentication
+type HTTPClientAuthenticationWithPreemptive struct {
+	BearerToken string                                  `json:"bearerToken,omitempty"`
+	NTLMDomain  string                                  `json:"ntlmDomain,omitempty"`
+	NTLMHost    string                                  `json:"ntlmHost,omitempty"`
+	Password    string                                  `json:"password,omitempty"`
+	Type        repository.HTTPClientAuthenticationType `json:"type"`
+	Username    string                                  `json:"username,omitempty"`
+	// Whether to use pre-emptive authentication. Use with caution. Defaults to false.
+	Preemptive *bool `json:"preemptive,omitempty"`
+}
@@ -0,0 +1,67 @@
+// Package nexus3 complements github.com/datadrivers/go-nexus-client with Nexus
+// API endpoints which are not (yet) supported by the client library.
+// It reuses the low level HTTP client of an existing nexus.NexusClient, so no
+// additional provider configuration is required.
+package nexus3
+
+import (
+	"fmt"
+	"sync"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+// configs remembers the connection settings of the clients created by the
+// provider, the low level client does not expose them
+var configs sync.Map
+
+type NexusClient struct {
+	client *client.Client
+
+	// API Services
+	BlobStore     *BlobStoreService
+	Capability    *CapabilityService
+	CleanupPolicy *CleanupPolicyService
+	Component     *ComponentService
+	Firewall      *FirewallService
+	Repository    *RepositoryService
+	Security      *SecurityService
+}
+
+// NewClient returns an instance of the extension client sharing the
+// connection settings of the given go-nexus-client instance
+func NewClient(nexusClient *nexus.NexusClient) *NexusClient {
+	// All services of go-nexus-client share the same low level client,
+	// the blobstore service is just the one exporting it.
+	c := nexusClient.BlobStore.Client
+	return &NexusClient{
+		client:        c,
+		BlobStore:     NewBlobStoreService(c),
+		Capability:    NewCapabilityService(c),
+		CleanupPolicy: NewCleanupPolicyService(c),
+		Component:     NewComponentService(c),
+		Firewall:      NewFirewallService(c),
+		Repository:    NewRepositoryService(c),
+		Security:      NewSecurityService(c),
+	}
+}
+
+// RegisterConfig remembers the connection settings of a go-nexus-client instance
+func RegisterConfig(nexusClient *nexus.NexusClient, config client.Config) {
+	configs.Store(nexusClient, config)
+}
+
+// NewClientWithCredentials returns a go-nexus-client instance connecting to
+// the same Nexus as the given one, but authenticating with other credentials
+func NewClientWithCredentials(nexusClient *nexus.NexusClient, username string, password string) (*nexus.NexusClient, error) {
+	value, ok := configs.Load(nexusClient)
+	if !ok {
+		return nil, fmt.Errorf("connection settings of the nexus client are unknown")
+	}
+
+	config := value.(client.Config)
+	config.Username = username
+	config.Password = password
+	return nexus.NewClient(config), nil
+}
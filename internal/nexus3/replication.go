@@ -0,0 +1,9 @@
+package nexus3
+
+// Replication contains the pull replication settings of a proxy repository (Nexus Pro only)
+type Replication struct {
+	// Regular expression of the asset paths to replicate
+	AssetPathRegex *string `json:"assetPathRegex,omitempty"`
+	// Whether pre-emptive pull of replicated content is enabled
+	PreemptivePullEnabled bool `json:"preemptivePullEnabled"`
+}
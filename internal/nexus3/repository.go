@@ -0,0 +1,214 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	repositoryAPIEndpoint = common.RepositoryAPIEndpoint
+)
+
+type RepositoryService struct {
+	client *client.Client
+
+	// API Services
+	Apt       *RepositoryAptService
+	Bower     *RepositoryBowerService
+	Cargo     *RepositoryCargoService
+	Cocoapods *RepositoryCocoapodsService
+	Composer  *RepositoryComposerService
+	Conan     *RepositoryConanService
+	Conda     *RepositoryCondaService
+	Docker    *RepositoryDockerService
+	Go        *RepositoryGoService
+	Group     *RepositoryGroupService
+	Helm      *RepositoryHelmService
+	Maven     *RepositoryMavenService
+	Npm       *RepositoryNpmService
+	Nuget     *RepositoryNugetService
+	P2        *RepositoryP2Service
+	Pypi      *RepositoryPypiService
+	R         *RepositoryRService
+	Raw       *RepositoryRawService
+	RubyGems  *RepositoryRubyGemsService
+	Yum       *RepositoryYumService
+}
+
+func NewRepositoryService(c *client.Client) *RepositoryService {
+	return &RepositoryService{
+		client: c,
+
+		Apt:       NewRepositoryAptService(c),
+		Bower:     NewRepositoryBowerService(c),
+		Cargo:     NewRepositoryCargoService(c),
+		Cocoapods: NewRepositoryCocoapodsService(c),
+		Composer:  NewRepositoryComposerService(c),
+		Conan:     NewRepositoryConanService(c),
+		Conda:     NewRepositoryCondaService(c),
+		Docker:    NewRepositoryDockerService(c),
+		Go:        NewRepositoryGoService(c),
+		Group:     NewRepositoryGroupService(c),
+		Helm:      NewRepositoryHelmService(c),
+		Maven:     NewRepositoryMavenService(c),
+		Npm:       NewRepositoryNpmService(c),
+		Nuget:     NewRepositoryNugetService(c),
+		P2:        NewRepositoryP2Service(c),
+		Pypi:      NewRepositoryPypiService(c),
+		R:         NewRepositoryRService(c),
+		Raw:       NewRepositoryRawService(c),
+		RubyGems:  NewRepositoryRubyGemsService(c),
+		Yum:       NewRepositoryYumService(c),
+	}
+}
+
+func createRepository(c *client.Client, endpoint string, name string, repo interface{}) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := c.Post(endpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func getRepository(c *client.Client, endpoint string, id string, repo interface{}) error {
+	body, err := getRepositoryBody(c, endpoint, id)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, repo); err != nil {
+		return fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return nil
+}
+
+func getRepositoryBody(c *client.Client, endpoint string, id string) ([]byte, error) {
+	body, resp, err := c.Get(fmt.Sprintf("%s/%s", endpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// GetWithURL reads a repository from the API of the format and type, e.g.
+// raw and hosted, into repo and returns the URL of the repository. This is
+// used for the repository types of go-nexus-client, which do not contain the
+// URL returned by Nexus.
+func (s *RepositoryService) GetWithURL(format string, repositoryType string, id string, repo interface{}) (string, error) {
+	body, err := getRepositoryBody(s.client, fmt.Sprintf("%s/%s/%s", repositoryAPIEndpoint, format, repositoryType), id)
+	if err != nil {
+		return "", err
+	}
+
+	var info repository.RepositoryInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return "", fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	if err := json.Unmarshal(body, repo); err != nil {
+		return "", fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return info.URL, nil
+}
+
+func updateRepository(c *client.Client, endpoint string, id string, repo interface{}) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := c.Put(fmt.Sprintf("%s/%s", endpoint, id), data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// Get returns the format independent information of a repository, nil if it
+// does not exist
+func (s *RepositoryService) Get(id string) (*repository.RepositoryInfo, error) {
+	return getRepositoryInfo(s.client, id)
+}
+
+func getRepositoryInfo(c *client.Client, id string) (*repository.RepositoryInfo, error) {
+	body, resp, err := c.Get(fmt.Sprintf("%s/%s", repositoryAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+
+	var info repository.RepositoryInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &info, nil
+}
+
+// InvalidateCache invalidates the cache of a proxy or group repository
+func (s *RepositoryService) InvalidateCache(id string) error {
+	body, resp, err := s.client.Post(fmt.Sprintf("%s/%s/invalidate-cache", repositoryAPIEndpoint, id), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not invalidate cache of repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// RebuildIndex schedules the rebuild of the search index of a repository
+func (s *RepositoryService) RebuildIndex(id string) error {
+	body, resp, err := s.client.Post(fmt.Sprintf("%s/%s/rebuild-index", repositoryAPIEndpoint, id), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not rebuild index of repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// EnableHealthCheck enables the Repository Health Check of a proxy repository
+func (s *RepositoryService) EnableHealthCheck(id string) error {
+	body, resp, err := s.client.Post(fmt.Sprintf("%s/%s/health-check", repositoryAPIEndpoint, id), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not enable health check of repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// DisableHealthCheck disables the Repository Health Check of a proxy repository
+func (s *RepositoryService) DisableHealthCheck(id string) error {
+	body, resp, err := s.client.Delete(fmt.Sprintf("%s/%s/health-check", repositoryAPIEndpoint, id))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not disable health check of repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
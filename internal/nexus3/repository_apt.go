@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	aptAPIEndpoint      = repositoryAPIEndpoint + "/apt"
+	aptProxyAPIEndpoint = aptAPIEndpoint + "/proxy"
+)
+
+type AptProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+	Apt                      repository.AptProxy `json:"apt"`
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryAptService struct {
+	client *client.Client
+
+	Proxy *RepositoryAptProxyService
+}
+
+func NewRepositoryAptService(c *client.Client) *RepositoryAptService {
+	return &RepositoryAptService{
+		client: c,
+
+		Proxy: NewRepositoryAptProxyService(c),
+	}
+}
+
+type RepositoryAptProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryAptProxyService(c *client.Client) *RepositoryAptProxyService {
+	return &RepositoryAptProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryAptProxyService) Create(repo AptProxyRepository) error {
+	return createRepository(s.client, aptProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryAptProxyService) Get(id string) (*AptProxyRepository, error) {
+	var repo AptProxyRepository
+	if err := getRepository(s.client, aptProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryAptProxyService) Update(id string, repo AptProxyRepository) error {
+	return updateRepository(s.client, aptProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryAptProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
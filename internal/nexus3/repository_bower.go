@@ -0,0 +1,79 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	bowerAPIEndpoint      = repositoryAPIEndpoint + "/bower"
+	bowerProxyAPIEndpoint = bowerAPIEndpoint + "/proxy"
+)
+
+type BowerProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+	repository.Bower    `json:"bower"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryBowerService struct {
+	client *client.Client
+
+	Proxy *RepositoryBowerProxyService
+}
+
+func NewRepositoryBowerService(c *client.Client) *RepositoryBowerService {
+	return &RepositoryBowerService{
+		client: c,
+
+		Proxy: NewRepositoryBowerProxyService(c),
+	}
+}
+
+type RepositoryBowerProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryBowerProxyService(c *client.Client) *RepositoryBowerProxyService {
+	return &RepositoryBowerProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryBowerProxyService) Create(repo BowerProxyRepository) error {
+	return createRepository(s.client, bowerProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryBowerProxyService) Get(id string) (*BowerProxyRepository, error) {
+	var repo BowerProxyRepository
+	if err := getRepository(s.client, bowerProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryBowerProxyService) Update(id string, repo BowerProxyRepository) error {
+	return updateRepository(s.client, bowerProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryBowerProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,166 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	cargoAPIEndpoint       = repositoryAPIEndpoint + "/cargo"
+	cargoGroupAPIEndpoint  = cargoAPIEndpoint + "/group"
+	cargoHostedAPIEndpoint = cargoAPIEndpoint + "/hosted"
+	cargoProxyAPIEndpoint  = cargoAPIEndpoint + "/proxy"
+)
+
+type CargoGroupRepository struct {
+	Name               string `json:"name"`
+	Online             bool   `json:"online"`
+	repository.Group   `json:"group"`
+	repository.Storage `json:"storage"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type CargoHostedRepository struct {
+	Name    string                   `json:"name"`
+	Online  bool                     `json:"online"`
+	Storage repository.HostedStorage `json:"storage"`
+
+	*repository.Cleanup   `json:"cleanup,omitempty"`
+	*repository.Component `json:"component,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type CargoProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryCargoService struct {
+	client *client.Client
+
+	Group  *RepositoryCargoGroupService
+	Hosted *RepositoryCargoHostedService
+	Proxy  *RepositoryCargoProxyService
+}
+
+func NewRepositoryCargoService(c *client.Client) *RepositoryCargoService {
+	return &RepositoryCargoService{
+		client: c,
+
+		Group:  NewRepositoryCargoGroupService(c),
+		Hosted: NewRepositoryCargoHostedService(c),
+		Proxy:  NewRepositoryCargoProxyService(c),
+	}
+}
+
+type RepositoryCargoGroupService struct {
+	client *client.Client
+}
+
+func NewRepositoryCargoGroupService(c *client.Client) *RepositoryCargoGroupService {
+	return &RepositoryCargoGroupService{
+		client: c,
+	}
+}
+
+func (s *RepositoryCargoGroupService) Create(repo CargoGroupRepository) error {
+	return createRepository(s.client, cargoGroupAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryCargoGroupService) Get(id string) (*CargoGroupRepository, error) {
+	var repo CargoGroupRepository
+	if err := getRepository(s.client, cargoGroupAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryCargoGroupService) Update(id string, repo CargoGroupRepository) error {
+	return updateRepository(s.client, cargoGroupAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryCargoGroupService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
+
+type RepositoryCargoHostedService struct {
+	client *client.Client
+}
+
+func NewRepositoryCargoHostedService(c *client.Client) *RepositoryCargoHostedService {
+	return &RepositoryCargoHostedService{
+		client: c,
+	}
+}
+
+func (s *RepositoryCargoHostedService) Create(repo CargoHostedRepository) error {
+	return createRepository(s.client, cargoHostedAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryCargoHostedService) Get(id string) (*CargoHostedRepository, error) {
+	var repo CargoHostedRepository
+	if err := getRepository(s.client, cargoHostedAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryCargoHostedService) Update(id string, repo CargoHostedRepository) error {
+	return updateRepository(s.client, cargoHostedAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryCargoHostedService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
+
+type RepositoryCargoProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryCargoProxyService(c *client.Client) *RepositoryCargoProxyService {
+	return &RepositoryCargoProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryCargoProxyService) Create(repo CargoProxyRepository) error {
+	return createRepository(s.client, cargoProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryCargoProxyService) Get(id string) (*CargoProxyRepository, error) {
+	var repo CargoProxyRepository
+	if err := getRepository(s.client, cargoProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryCargoProxyService) Update(id string, repo CargoProxyRepository) error {
+	return updateRepository(s.client, cargoProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryCargoProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
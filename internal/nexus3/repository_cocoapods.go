@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	cocoapodsAPIEndpoint      = repositoryAPIEndpoint + "/cocoapods"
+	cocoapodsProxyAPIEndpoint = cocoapodsAPIEndpoint + "/proxy"
+)
+
+type CocoapodsProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryCocoapodsService struct {
+	client *client.Client
+
+	Proxy *RepositoryCocoapodsProxyService
+}
+
+func NewRepositoryCocoapodsService(c *client.Client) *RepositoryCocoapodsService {
+	return &RepositoryCocoapodsService{
+		client: c,
+
+		Proxy: NewRepositoryCocoapodsProxyService(c),
+	}
+}
+
+type RepositoryCocoapodsProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryCocoapodsProxyService(c *client.Client) *RepositoryCocoapodsProxyService {
+	return &RepositoryCocoapodsProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryCocoapodsProxyService) Create(repo CocoapodsProxyRepository) error {
+	return createRepository(s.client, cocoapodsProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryCocoapodsProxyService) Get(id string) (*CocoapodsProxyRepository, error) {
+	var repo CocoapodsProxyRepository
+	if err := getRepository(s.client, cocoapodsProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryCocoapodsProxyService) Update(id string, repo CocoapodsProxyRepository) error {
+	return updateRepository(s.client, cocoapodsProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryCocoapodsProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
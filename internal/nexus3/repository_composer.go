@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	composerAPIEndpoint      = repositoryAPIEndpoint + "/composer"
+	composerProxyAPIEndpoint = composerAPIEndpoint + "/proxy"
+)
+
+type ComposerProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryComposerService struct {
+	client *client.Client
+
+	Proxy *RepositoryComposerProxyService
+}
+
+func NewRepositoryComposerService(c *client.Client) *RepositoryComposerService {
+	return &RepositoryComposerService{
+		client: c,
+
+		Proxy: NewRepositoryComposerProxyService(c),
+	}
+}
+
+type RepositoryComposerProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryComposerProxyService(c *client.Client) *RepositoryComposerProxyService {
+	return &RepositoryComposerProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryComposerProxyService) Create(repo ComposerProxyRepository) error {
+	return createRepository(s.client, composerProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryComposerProxyService) Get(id string) (*ComposerProxyRepository, error) {
+	var repo ComposerProxyRepository
+	if err := getRepository(s.client, composerProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryComposerProxyService) Update(id string, repo ComposerProxyRepository) error {
+	return updateRepository(s.client, composerProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryComposerProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,137 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	conanAPIEndpoint       = repositoryAPIEndpoint + "/conan"
+	conanHostedAPIEndpoint = conanAPIEndpoint + "/hosted"
+	conanProxyAPIEndpoint  = conanAPIEndpoint + "/proxy"
+)
+
+type ConanHostedRepository struct {
+	Name    string                   `json:"name"`
+	Online  bool                     `json:"online"`
+	Storage repository.HostedStorage `json:"storage"`
+
+	*repository.Cleanup   `json:"cleanup,omitempty"`
+	*repository.Component `json:"component,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type ConanProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+	*ConanProxy         `json:"conanProxy,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+const (
+	ConanVersionV1 ConanVersion = "V1"
+	ConanVersionV2 ConanVersion = "V2"
+)
+
+// Conan protocol version of a conan proxy repository
+type ConanVersion string
+
+type ConanProxy struct {
+	// Conan protocol version served by the repository
+	ConanVersion ConanVersion `json:"conanVersion"`
+}
+
+type RepositoryConanService struct {
+	client *client.Client
+
+	Hosted *RepositoryConanHostedService
+	Proxy  *RepositoryConanProxyService
+}
+
+func NewRepositoryConanService(c *client.Client) *RepositoryConanService {
+	return &RepositoryConanService{
+		client: c,
+
+		Hosted: NewRepositoryConanHostedService(c),
+		Proxy:  NewRepositoryConanProxyService(c),
+	}
+}
+
+type RepositoryConanHostedService struct {
+	client *client.Client
+}
+
+func NewRepositoryConanHostedService(c *client.Client) *RepositoryConanHostedService {
+	return &RepositoryConanHostedService{
+		client: c,
+	}
+}
+
+func (s *RepositoryConanHostedService) Create(repo ConanHostedRepository) error {
+	return createRepository(s.client, conanHostedAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryConanHostedService) Get(id string) (*ConanHostedRepository, error) {
+	var repo ConanHostedRepository
+	if err := getRepository(s.client, conanHostedAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryConanHostedService) Update(id string, repo ConanHostedRepository) error {
+	return updateRepository(s.client, conanHostedAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryConanHostedService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
+
+type RepositoryConanProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryConanProxyService(c *client.Client) *RepositoryConanProxyService {
+	return &RepositoryConanProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryConanProxyService) Create(repo ConanProxyRepository) error {
+	return createRepository(s.client, conanProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryConanProxyService) Get(id string) (*ConanProxyRepository, error) {
+	var repo ConanProxyRepository
+	if err := getRepository(s.client, conanProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryConanProxyService) Update(id string, repo ConanProxyRepository) error {
+	return updateRepository(s.client, conanProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryConanProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	condaAPIEndpoint      = repositoryAPIEndpoint + "/conda"
+	condaProxyAPIEndpoint = condaAPIEndpoint + "/proxy"
+)
+
+type CondaProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryCondaService struct {
+	client *client.Client
+
+	Proxy *RepositoryCondaProxyService
+}
+
+func NewRepositoryCondaService(c *client.Client) *RepositoryCondaService {
+	return &RepositoryCondaService{
+		client: c,
+
+		Proxy: NewRepositoryCondaProxyService(c),
+	}
+}
+
+type RepositoryCondaProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryCondaProxyService(c *client.Client) *RepositoryCondaProxyService {
+	return &RepositoryCondaProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryCondaProxyService) Create(repo CondaProxyRepository) error {
+	return createRepository(s.client, condaProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryCondaProxyService) Get(id string) (*CondaProxyRepository, error) {
+	var repo CondaProxyRepository
+	if err := getRepository(s.client, condaProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryCondaProxyService) Update(id string, repo CondaProxyRepository) error {
+	return updateRepository(s.client, condaProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryCondaProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
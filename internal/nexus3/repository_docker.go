@@ -0,0 +1,208 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	dockerAPIEndpoint       = repositoryAPIEndpoint + "/docker"
+	dockerGroupAPIEndpoint  = dockerAPIEndpoint + "/group"
+	dockerHostedAPIEndpoint = dockerAPIEndpoint + "/hosted"
+	dockerProxyAPIEndpoint  = dockerAPIEndpoint + "/proxy"
+)
+
+type DockerGroupRepository struct {
+	Name               string                 `json:"name"`
+	Online             bool                   `json:"online"`
+	Group              repository.GroupDeploy `json:"group"`
+	repository.Storage `json:"storage"`
+	Docker             `json:"docker"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type DockerHostedRepository struct {
+	Name    string              `json:"name"`
+	Online  bool                `json:"online"`
+	Storage DockerHostedStorage `json:"storage"`
+	Docker  `json:"docker"`
+
+	*repository.Cleanup   `json:"cleanup,omitempty"`
+	*repository.Component `json:"component,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+// DockerHostedStorage extends repository.HostedStorage with the docker specific latest policy
+type DockerHostedStorage struct {
+	// Blob store used to store repository contents
+	BlobStoreName string `json:"blobStoreName"`
+	// StrictContentTypeValidation: Whether to validate uploaded content's MIME type appropriate for the repository format
+	StrictContentTypeValidation bool `json:"strictContentTypeValidation"`
+	// WritePolicy controls if deployments of and updates to assets are allowed
+	WritePolicy *repository.StorageWritePolicy `json:"writePolicy,omitempty"`
+	// Whether to allow redeploying the 'latest' tag but defer to the write policy for all other tags
+	LatestPolicy *bool `json:"latestPolicy,omitempty"`
+}
+
+type DockerProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+	Docker                   `json:"docker"`
+	DockerProxy              `json:"dockerProxy"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+// Docker contains data of a Docker Repository
+type Docker struct {
+	// Whether to force authentication (Docker Bearer Token Realm required if false)
+	ForceBasicAuth bool `json:"forceBasicAuth"`
+	// Create an HTTP connector at specified port
+	HTTPPort *int `json:"httpPort,omitempty"`
+	// Create an HTTPS connector at specified port
+	HTTPSPort *int `json:"httpsPort,omitempty"`
+	// Allows to use repository name as subdomain (Pro only)
+	Subdomain *string `json:"subdomain,omitempty"`
+	// Whether to allow clients to use the V1 API to interact with this repository
+	V1Enabled bool `json:"v1Enabled"`
+}
+
+// DockerProxy contains data of a Docker Proxy Repository
+type DockerProxy struct {
+	// Allow Nexus Repository Manager to download and cache foreign layers
+	CacheForeignLayers *bool `json:"cacheForeignLayers,omitempty"`
+	// Regular expressions used to identify URLs that are allowed for foreign layer requests
+	ForeignLayerURLWhitelist []string `json:"foreignLayerUrlWhitelist,omitempty"`
+	// Type of Docker Index
+	IndexType repository.DockerProxyIndexType `json:"indexType"`
+	// Url of Docker Index to use
+	IndexURL *string `json:"indexUrl,omitempty"`
+}
+
+type RepositoryDockerService struct {
+	client *client.Client
+
+	Group  *RepositoryDockerGroupService
+	Hosted *RepositoryDockerHostedService
+	Proxy  *RepositoryDockerProxyService
+}
+
+func NewRepositoryDockerService(c *client.Client) *RepositoryDockerService {
+	return &RepositoryDockerService{
+		client: c,
+
+		Group:  NewRepositoryDockerGroupService(c),
+		Hosted: NewRepositoryDockerHostedService(c),
+		Proxy:  NewRepositoryDockerProxyService(c),
+	}
+}
+
+type RepositoryDockerGroupService struct {
+	client *client.Client
+}
+
+func NewRepositoryDockerGroupService(c *client.Client) *RepositoryDockerGroupService {
+	return &RepositoryDockerGroupService{
+		client: c,
+	}
+}
+
+func (s *RepositoryDockerGroupService) Create(repo DockerGroupRepository) error {
+	return createRepository(s.client, dockerGroupAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryDockerGroupService) Get(id string) (*DockerGroupRepository, error) {
+	var repo DockerGroupRepository
+	if err := getRepository(s.client, dockerGroupAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryDockerGroupService) Update(id string, repo DockerGroupRepository) error {
+	return updateRepository(s.client, dockerGroupAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryDockerGroupService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
+
+type RepositoryDockerHostedService struct {
+	client *client.Client
+}
+
+func NewRepositoryDockerHostedService(c *client.Client) *RepositoryDockerHostedService {
+	return &RepositoryDockerHostedService{
+		client: c,
+	}
+}
+
+func (s *RepositoryDockerHostedService) Create(repo DockerHostedRepository) error {
+	return createRepository(s.client, dockerHostedAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryDockerHostedService) Get(id string) (*DockerHostedRepository, error) {
+	var repo DockerHostedRepository
+	if err := getRepository(s.client, dockerHostedAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryDockerHostedService) Update(id string, repo DockerHostedRepository) error {
+	return updateRepository(s.client, dockerHostedAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryDockerHostedService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
+
+type RepositoryDockerProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryDockerProxyService(c *client.Client) *RepositoryDockerProxyService {
+	return &RepositoryDockerProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryDockerProxyService) Create(repo DockerProxyRepository) error {
+	return createRepository(s.client, dockerProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryDockerProxyService) Get(id string) (*DockerProxyRepository, error) {
+	var repo DockerProxyRepository
+	if err := getRepository(s.client, dockerProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryDockerProxyService) Update(id string, repo DockerProxyRepository) error {
+	return updateRepository(s.client, dockerProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryDockerProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
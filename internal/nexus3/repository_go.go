@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	goAPIEndpoint      = repositoryAPIEndpoint + "/go"
+	goProxyAPIEndpoint = goAPIEndpoint + "/proxy"
+)
+
+type GoProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               HTTPClientWithPreemptiveAuth `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryGoService struct {
+	client *client.Client
+
+	Proxy *RepositoryGoProxyService
+}
+
+func NewRepositoryGoService(c *client.Client) *RepositoryGoService {
+	return &RepositoryGoService{
+		client: c,
+
+		Proxy: NewRepositoryGoProxyService(c),
+	}
+}
+
+type RepositoryGoProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryGoProxyService(c *client.Client) *RepositoryGoProxyService {
+	return &RepositoryGoProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryGoProxyService) Create(repo GoProxyRepository) error {
+	return createRepository(s.client, goProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryGoProxyService) Get(id string) (*GoProxyRepository, error) {
+	var repo GoProxyRepository
+	if err := getRepository(s.client, goProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryGoProxyService) Update(id string, repo GoProxyRepository) error {
+	return updateRepository(s.client, goProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryGoProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
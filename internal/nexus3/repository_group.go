@@ -0,0 +1,99 @@
+package nexus3
+
+import (
+	"fmt"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+// RepositoryGroupService manages the members of group repositories of any
+// format. The group configuration is passed through unchanged, so settings
+// unknown to this client are preserved.
+type RepositoryGroupService struct {
+	client *client.Client
+}
+
+func NewRepositoryGroupService(c *client.Client) *RepositoryGroupService {
+	return &RepositoryGroupService{
+		client: c,
+	}
+}
+
+func groupAPIEndpoint(format string) string {
+	// The API path of maven repositories differs from their format name
+	if format == "maven2" {
+		format = "maven"
+	}
+	return fmt.Sprintf("%s/%s/%s", repositoryAPIEndpoint, format, repository.RepositoryTypeGroup)
+}
+
+func (s *RepositoryGroupService) get(name string) (string, map[string]interface{}, error) {
+	info, err := getRepositoryInfo(s.client, name)
+	if err != nil {
+		return "", nil, err
+	}
+	if info == nil {
+		return "", nil, nil
+	}
+	if info.Type != repository.RepositoryTypeGroup {
+		return "", nil, fmt.Errorf("repository '%s' is not a group repository but of type '%s'", name, info.Type)
+	}
+
+	var repo map[string]interface{}
+	endpoint := groupAPIEndpoint(info.Format)
+	if err := getRepository(s.client, endpoint, name, &repo); err != nil {
+		return "", nil, err
+	}
+	return endpoint, repo, nil
+}
+
+// GetMemberNames returns the ordered members of a group repository, nil if
+// the group does not exist
+func (s *RepositoryGroupService) GetMemberNames(name string) ([]string, error) {
+	_, repo, err := s.get(name)
+	if err != nil || repo == nil {
+		return nil, err
+	}
+	return groupMemberNames(repo), nil
+}
+
+// SetMemberNames replaces the members of a group repository
+func (s *RepositoryGroupService) SetMemberNames(name string, memberNames []string) error {
+	endpoint, repo, err := s.get(name)
+	if err != nil {
+		return err
+	}
+	if repo == nil {
+		return fmt.Errorf("group repository '%s' does not exist", name)
+	}
+
+	group, _ := repo["group"].(map[string]interface{})
+	if group == nil {
+		group = map[string]interface{}{}
+	}
+	group["memberNames"] = memberNames
+	repo["group"] = group
+
+	// Read-only attributes which are not part of the update request
+	delete(repo, "format")
+	delete(repo, "type")
+	delete(repo, "url")
+
+	return updateRepository(s.client, endpoint, name, repo)
+}
+
+func groupMemberNames(repo map[string]interface{}) []string {
+	memberNames := []string{}
+	group, _ := repo["group"].(map[string]interface{})
+	if group == nil {
+		return memberNames
+	}
+	members, _ := group["memberNames"].([]interface{})
+	for _, member := range members {
+		if memberName, ok := member.(string); ok {
+			memberNames = append(memberNames, memberName)
+		}
+	}
+	return memberNames
+}
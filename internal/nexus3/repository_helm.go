@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	helmAPIEndpoint      = repositoryAPIEndpoint + "/helm"
+	helmProxyAPIEndpoint = helmAPIEndpoint + "/proxy"
+)
+
+type HelmProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               HTTPClientWithPreemptiveAuth `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryHelmService struct {
+	client *client.Client
+
+	Proxy *RepositoryHelmProxyService
+}
+
+func NewRepositoryHelmService(c *client.Client) *RepositoryHelmService {
+	return &RepositoryHelmService{
+		client: c,
+
+		Proxy: NewRepositoryHelmProxyService(c),
+	}
+}
+
+type RepositoryHelmProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryHelmProxyService(c *client.Client) *RepositoryHelmProxyService {
+	return &RepositoryHelmProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryHelmProxyService) Create(repo HelmProxyRepository) error {
+	return createRepository(s.client, helmProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryHelmProxyService) Get(id string) (*HelmProxyRepository, error) {
+	var repo HelmProxyRepository
+	if err := getRepository(s.client, helmProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryHelmProxyService) Update(id string, repo HelmProxyRepository) error {
+	return updateRepository(s.client, helmProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryHelmProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	mavenAPIEndpoint      = repositoryAPIEndpoint + "/maven"
+	mavenProxyAPIEndpoint = mavenAPIEndpoint + "/proxy"
+)
+
+type MavenProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               HTTPClientWithPreemptiveAuth `json:"httpClient"`
+	repository.Maven         `json:"maven"`
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryMavenService struct {
+	client *client.Client
+
+	Proxy *RepositoryMavenProxyService
+}
+
+func NewRepositoryMavenService(c *client.Client) *RepositoryMavenService {
+	return &RepositoryMavenService{
+		client: c,
+
+		Proxy: NewRepositoryMavenProxyService(c),
+	}
+}
+
+type RepositoryMavenProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryMavenProxyService(c *client.Client) *RepositoryMavenProxyService {
+	return &RepositoryMavenProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryMavenProxyService) Create(repo MavenProxyRepository) error {
+	return createRepository(s.client, mavenProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryMavenProxyService) Get(id string) (*MavenProxyRepository, error) {
+	var repo MavenProxyRepository
+	if err := getRepository(s.client, mavenProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryMavenProxyService) Update(id string, repo MavenProxyRepository) error {
+	return updateRepository(s.client, mavenProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryMavenProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,79 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	npmAPIEndpoint      = repositoryAPIEndpoint + "/npm"
+	npmProxyAPIEndpoint = npmAPIEndpoint + "/proxy"
+)
+
+type NpmProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+	*repository.Npm     `json:"npm,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryNpmService struct {
+	client *client.Client
+
+	Proxy *RepositoryNpmProxyService
+}
+
+func NewRepositoryNpmService(c *client.Client) *RepositoryNpmService {
+	return &RepositoryNpmService{
+		client: c,
+
+		Proxy: NewRepositoryNpmProxyService(c),
+	}
+}
+
+type RepositoryNpmProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryNpmProxyService(c *client.Client) *RepositoryNpmProxyService {
+	return &RepositoryNpmProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryNpmProxyService) Create(repo NpmProxyRepository) error {
+	return createRepository(s.client, npmProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryNpmProxyService) Get(id string) (*NpmProxyRepository, error) {
+	var repo NpmProxyRepository
+	if err := getRepository(s.client, npmProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryNpmProxyService) Update(id string, repo NpmProxyRepository) error {
+	return updateRepository(s.client, npmProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryNpmProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	nugetAPIEndpoint      = repositoryAPIEndpoint + "/nuget"
+	nugetProxyAPIEndpoint = nugetAPIEndpoint + "/proxy"
+)
+
+type NugetProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+	repository.NugetProxy    `json:"nugetProxy"`
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryNugetService struct {
+	client *client.Client
+
+	Proxy *RepositoryNugetProxyService
+}
+
+func NewRepositoryNugetService(c *client.Client) *RepositoryNugetService {
+	return &RepositoryNugetService{
+		client: c,
+
+		Proxy: NewRepositoryNugetProxyService(c),
+	}
+}
+
+type RepositoryNugetProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryNugetProxyService(c *client.Client) *RepositoryNugetProxyService {
+	return &RepositoryNugetProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryNugetProxyService) Create(repo NugetProxyRepository) error {
+	return createRepository(s.client, nugetProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryNugetProxyService) Get(id string) (*NugetProxyRepository, error) {
+	var repo NugetProxyRepository
+	if err := getRepository(s.client, nugetProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryNugetProxyService) Update(id string, repo NugetProxyRepository) error {
+	return updateRepository(s.client, nugetProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryNugetProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
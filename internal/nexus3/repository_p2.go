@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	p2APIEndpoint      = repositoryAPIEndpoint + "/p2"
+	p2ProxyAPIEndpoint = p2APIEndpoint + "/proxy"
+)
+
+type P2ProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryP2Service struct {
+	client *client.Client
+
+	Proxy *RepositoryP2ProxyService
+}
+
+func NewRepositoryP2Service(c *client.Client) *RepositoryP2Service {
+	return &RepositoryP2Service{
+		client: c,
+
+		Proxy: NewRepositoryP2ProxyService(c),
+	}
+}
+
+type RepositoryP2ProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryP2ProxyService(c *client.Client) *RepositoryP2ProxyService {
+	return &RepositoryP2ProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryP2ProxyService) Create(repo P2ProxyRepository) error {
+	return createRepository(s.client, p2ProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryP2ProxyService) Get(id string) (*P2ProxyRepository, error) {
+	var repo P2ProxyRepository
+	if err := getRepository(s.client, p2ProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryP2ProxyService) Update(id string, repo P2ProxyRepository) error {
+	return updateRepository(s.client, p2ProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryP2ProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,85 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	pypiAPIEndpoint      = repositoryAPIEndpoint + "/pypi"
+	pypiProxyAPIEndpoint = pypiAPIEndpoint + "/proxy"
+)
+
+type PypiProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+	*Pypi               `json:"pypi,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+// Pypi contains additional data of a PyPI proxy repository
+type Pypi struct {
+	// Remove quarantined versions from the simple index (requires IQ Firewall)
+	RemoveQuarantined bool `json:"removeQuarantined"`
+}
+
+type RepositoryPypiService struct {
+	client *client.Client
+
+	Proxy *RepositoryPypiProxyService
+}
+
+func NewRepositoryPypiService(c *client.Client) *RepositoryPypiService {
+	return &RepositoryPypiService{
+		client: c,
+
+		Proxy: NewRepositoryPypiProxyService(c),
+	}
+}
+
+type RepositoryPypiProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryPypiProxyService(c *client.Client) *RepositoryPypiProxyService {
+	return &RepositoryPypiProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryPypiProxyService) Create(repo PypiProxyRepository) error {
+	return createRepository(s.client, pypiProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryPypiProxyService) Get(id string) (*PypiProxyRepository, error) {
+	var repo PypiProxyRepository
+	if err := getRepository(s.client, pypiProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryPypiProxyService) Update(id string, repo PypiProxyRepository) error {
+	return updateRepository(s.client, pypiProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryPypiProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
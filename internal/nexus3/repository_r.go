@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	rAPIEndpoint      = repositoryAPIEndpoint + "/r"
+	rProxyAPIEndpoint = rAPIEndpoint + "/proxy"
+)
+
+type RProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryRService struct {
+	client *client.Client
+
+	Proxy *RepositoryRProxyService
+}
+
+func NewRepositoryRService(c *client.Client) *RepositoryRService {
+	return &RepositoryRService{
+		client: c,
+
+		Proxy: NewRepositoryRProxyService(c),
+	}
+}
+
+type RepositoryRProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryRProxyService(c *client.Client) *RepositoryRProxyService {
+	return &RepositoryRProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryRProxyService) Create(repo RProxyRepository) error {
+	return createRepository(s.client, rProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryRProxyService) Get(id string) (*RProxyRepository, error) {
+	var repo RProxyRepository
+	if err := getRepository(s.client, rProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryRProxyService) Update(id string, repo RProxyRepository) error {
+	return updateRepository(s.client, rProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryRProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
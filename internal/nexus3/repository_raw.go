@@ -0,0 +1,79 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	rawAPIEndpoint      = repositoryAPIEndpoint + "/raw"
+	rawProxyAPIEndpoint = rawAPIEndpoint + "/proxy"
+)
+
+type RawProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               HTTPClientWithPreemptiveAuth `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+	*repository.Raw     `json:"raw,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryRawService struct {
+	client *client.Client
+
+	Proxy *RepositoryRawProxyService
+}
+
+func NewRepositoryRawService(c *client.Client) *RepositoryRawService {
+	return &RepositoryRawService{
+		client: c,
+
+		Proxy: NewRepositoryRawProxyService(c),
+	}
+}
+
+type RepositoryRawProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryRawProxyService(c *client.Client) *RepositoryRawProxyService {
+	return &RepositoryRawProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryRawProxyService) Create(repo RawProxyRepository) error {
+	return createRepository(s.client, rawProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryRawProxyService) Get(id string) (*RawProxyRepository, error) {
+	var repo RawProxyRepository
+	if err := getRepository(s.client, rawProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryRawProxyService) Update(id string, repo RawProxyRepository) error {
+	return updateRepository(s.client, rawProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryRawProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,78 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	rubyGemsAPIEndpoint      = repositoryAPIEndpoint + "/rubygems"
+	rubyGemsProxyAPIEndpoint = rubyGemsAPIEndpoint + "/proxy"
+)
+
+type RubyGemsProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup `json:"cleanup,omitempty"`
+	*Replication        `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryRubyGemsService struct {
+	client *client.Client
+
+	Proxy *RepositoryRubyGemsProxyService
+}
+
+func NewRepositoryRubyGemsService(c *client.Client) *RepositoryRubyGemsService {
+	return &RepositoryRubyGemsService{
+		client: c,
+
+		Proxy: NewRepositoryRubyGemsProxyService(c),
+	}
+}
+
+type RepositoryRubyGemsProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryRubyGemsProxyService(c *client.Client) *RepositoryRubyGemsProxyService {
+	return &RepositoryRubyGemsProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryRubyGemsProxyService) Create(repo RubyGemsProxyRepository) error {
+	return createRepository(s.client, rubyGemsProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryRubyGemsProxyService) Get(id string) (*RubyGemsProxyRepository, error) {
+	var repo RubyGemsProxyRepository
+	if err := getRepository(s.client, rubyGemsProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryRubyGemsProxyService) Update(id string, repo RubyGemsProxyRepository) error {
+	return updateRepository(s.client, rubyGemsProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryRubyGemsProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
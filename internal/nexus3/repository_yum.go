@@ -0,0 +1,79 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/repository/common"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+const (
+	yumAPIEndpoint      = repositoryAPIEndpoint + "/yum"
+	yumProxyAPIEndpoint = yumAPIEndpoint + "/proxy"
+)
+
+type YumProxyRepository struct {
+	Name                     string `json:"name"`
+	Online                   bool   `json:"online"`
+	repository.Storage       `json:"storage"`
+	repository.Proxy         `json:"proxy"`
+	repository.NegativeCache `json:"negativeCache"`
+	HTTPClient               `json:"httpClient"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName. see issue: https://issues.sonatype.org/browse/NEXUS-30973
+
+	// The name of the routing rule assigned to this repository
+	RoutingRule *string `json:"routingRule,omitempty"`
+	// The name of the routing rule assigned to this repository
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	*repository.Cleanup    `json:"cleanup,omitempty"`
+	*repository.YumSigning `json:"yumSigning,omitempty"`
+	*Replication           `json:"replication,omitempty"`
+
+	// Read-only URL of the repository, which is not part of requests
+	URL string `json:"url,omitempty"`
+}
+
+type RepositoryYumService struct {
+	client *client.Client
+
+	Proxy *RepositoryYumProxyService
+}
+
+func NewRepositoryYumService(c *client.Client) *RepositoryYumService {
+	return &RepositoryYumService{
+		client: c,
+
+		Proxy: NewRepositoryYumProxyService(c),
+	}
+}
+
+type RepositoryYumProxyService struct {
+	client *client.Client
+}
+
+func NewRepositoryYumProxyService(c *client.Client) *RepositoryYumProxyService {
+	return &RepositoryYumProxyService{
+		client: c,
+	}
+}
+
+func (s *RepositoryYumProxyService) Create(repo YumProxyRepository) error {
+	return createRepository(s.client, yumProxyAPIEndpoint, repo.Name, repo)
+}
+
+func (s *RepositoryYumProxyService) Get(id string) (*YumProxyRepository, error) {
+	var repo YumProxyRepository
+	if err := getRepository(s.client, yumProxyAPIEndpoint, id, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryYumProxyService) Update(id string, repo YumProxyRepository) error {
+	return updateRepository(s.client, yumProxyAPIEndpoint, id, repo)
+}
+
+func (s *RepositoryYumProxyService) Delete(id string) error {
+	return common.DeleteRepository(s.client, id)
+}
@@ -0,0 +1,28 @@
+package nexus3
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+)
+
+const (
+	securityAPIEndpoint = client.BasePath + "v1/security"
+)
+
+type SecurityService struct {
+	client *client.Client
+
+	// API Services
+	Crowd *SecurityCrowdService
+	Role  *SecurityRoleService
+	User  *SecurityUserService
+}
+
+func NewSecurityService(c *client.Client) *SecurityService {
+	return &SecurityService{
+		client: c,
+
+		Crowd: NewSecurityCrowdService(c),
+		Role:  NewSecurityRoleService(c),
+		User:  NewSecurityUserService(c),
+	}
+}
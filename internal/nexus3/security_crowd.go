@@ -0,0 +1,65 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
+)
+
+const (
+	// Pro-only
+	securityCrowdAPIEndpoint = securityAPIEndpoint + "/atlassian-crowd"
+)
+
+type SecurityCrowd struct {
+	RealmActive         bool   `json:"realmActive"`
+	ServerURL           string `json:"serverUrl"`
+	ApplicationName     string `json:"applicationName"`
+	ApplicationPassword string `json:"applicationPassword,omitempty"`
+	UseTrustStoreForURL bool   `json:"useTrustStoreForUrl"`
+	Timeout             int    `json:"timeout,omitempty"`
+}
+
+type SecurityCrowdService struct {
+	client *client.Client
+}
+
+func NewSecurityCrowdService(c *client.Client) *SecurityCrowdService {
+	return &SecurityCrowdService{
+		client: c,
+	}
+}
+
+func (s *SecurityCrowdService) Get() (*SecurityCrowd, error) {
+	body, resp, err := s.client.Get(securityCrowdAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read crowd settings: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var crowd SecurityCrowd
+	if err := json.Unmarshal(body, &crowd); err != nil {
+		return nil, fmt.Errorf("could not unmarshal crowd settings: %v", err)
+	}
+	return &crowd, nil
+}
+
+func (s *SecurityCrowdService) Update(crowd SecurityCrowd) error {
+	data, err := tools.JsonMarshalInterfaceToIOReader(crowd)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Put(securityCrowdAPIEndpoint, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update crowd settings: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+const (
+	securityRolesAPIEndpoint = securityAPIEndpoint + "/roles"
+)
+
+type SecurityRoleService struct {
+	client *client.Client
+}
+
+func NewSecurityRoleService(c *client.Client) *SecurityRoleService {
+	return &SecurityRoleService{
+		client: c,
+	}
+}
+
+// List returns all roles of the default role source
+func (s *SecurityRoleService) List() ([]security.Role, error) {
+	body, resp, err := s.client.Get(securityRolesAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp, body, "could not list roles")
+	}
+
+	var roles []security.Role
+	if err := json.Unmarshal(body, &roles); err != nil {
+		return nil, fmt.Errorf("could not unmarshal roles: %v", err)
+	}
+	return roles, nil
+}
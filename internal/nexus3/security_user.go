@@ -0,0 +1,76 @@
+package nexus3
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+const (
+	securityUsersAPIEndpoint = securityAPIEndpoint + "/users"
+)
+
+type SecurityUserService struct {
+	client *client.Client
+}
+
+func NewSecurityUserService(c *client.Client) *SecurityUserService {
+	return &SecurityUserService{
+		client: c,
+	}
+}
+
+// List returns the users whose userid starts with userIDPrefix. An empty
+// source searches all user sources.
+func (s *SecurityUserService) List(userIDPrefix string, source string) ([]security.User, error) {
+	query := url.Values{}
+	if userIDPrefix != "" {
+		query.Set("userId", userIDPrefix)
+	}
+	if source != "" {
+		query.Set("source", source)
+	}
+
+	endpoint := securityUsersAPIEndpoint
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+
+	body, resp, err := s.client.Get(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list users: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+
+	var users []security.User
+	if err := json.Unmarshal(body, &users); err != nil {
+		return nil, fmt.Errorf("could not unmarshal users: %v", err)
+	}
+	return users, nil
+}
+
+// CanAuthenticate reports whether Nexus accepts the credentials of the client
+// for reading the given user. Errors other than HTTP 401 and 403 are returned.
+func (s *SecurityUserService) CanAuthenticate(userID string) (bool, error) {
+	query := url.Values{}
+	query.Set("userId", userID)
+
+	body, resp, err := s.client.Get(fmt.Sprintf("%s?%s", securityUsersAPIEndpoint, query.Encode()), nil)
+	if err != nil {
+		return false, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return false, nil
+	default:
+		return false, fmt.Errorf("could not authenticate user '%s': HTTP: %d, %s", userID, resp.StatusCode, string(body))
+	}
+}
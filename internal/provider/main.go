@@ -3,6 +3,7 @@ package provider
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/services/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/services/deprecated"
 	"github.com/datadrivers/terraform-provider-nexus/internal/services/other"
@@ -28,7 +29,12 @@ func Provider() *schema.Provider {
 			"nexus_repository_bower_group":     repository.DataSourceRepositoryBowerGroup(),
 			"nexus_repository_bower_hosted":    repository.DataSourceRepositoryBowerHosted(),
 			"nexus_repository_bower_proxy":     repository.DataSourceRepositoryBowerProxy(),
+			"nexus_repository_cargo_group":     repository.DataSourceRepositoryCargoGroup(),
+			"nexus_repository_cargo_hosted":    repository.DataSourceRepositoryCargoHosted(),
+			"nexus_repository_cargo_proxy":     repository.DataSourceRepositoryCargoProxy(),
 			"nexus_repository_cocoapods_proxy": repository.DataSourceRepositoryCocoapodsProxy(),
+			"nexus_repository_composer_proxy":  repository.DataSourceRepositoryComposerProxy(),
+			"nexus_repository_conan_hosted":    repository.DataSourceRepositoryConanHosted(),
 			"nexus_repository_conan_proxy":     repository.DataSourceRepositoryConanProxy(),
 			"nexus_repository_conda_proxy":     repository.DataSourceRepositoryCondaProxy(),
 			"nexus_repository_docker_group":    repository.DataSourceRepositoryDockerGroup(),
@@ -68,78 +74,106 @@ func Provider() *schema.Provider {
 			"nexus_routing_rule":               other.DataSourceRoutingRule(),
 			"nexus_security_anonymous":         security.DataSourceSecurityAnonymous(),
 			"nexus_security_content_selector":  security.DataSourceSecurityContentSelector(),
+			"nexus_security_content_selectors": security.DataSourceSecurityContentSelectors(),
 			"nexus_security_ldap":              security.DataSourceSecurityLDAP(),
 			"nexus_security_realms":            security.DataSourceSecurityRealms(),
 			"nexus_security_role":              security.DataSourceSecurityRole(),
 			"nexus_security_saml":              security.DataSourceSecuritySAML(),
+			"nexus_security_ssl_certificate":   security.DataSourceSecuritySSLCertificate(),
 			"nexus_security_user":              security.DataSourceSecurityUser(),
 			"nexus_security_user_token":        security.DataSourceSecurityUserToken(),
+			"nexus_security_users":             security.DataSourceSecurityUsers(),
 			"nexus_user":                       deprecated.DataSourceUser(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"nexus_anonymous":                  deprecated.ResourceAnonymous(),
-			"nexus_blobstore":                  deprecated.ResourceBlobstore(),
-			"nexus_blobstore_azure":            blobstore.ResourceBlobstoreAzure(),
-			"nexus_blobstore_file":             blobstore.ResourceBlobstoreFile(),
-			"nexus_blobstore_group":            blobstore.ResourceBlobstoreGroup(),
-			"nexus_blobstore_s3":               blobstore.ResourceBlobstoreS3(),
-			"nexus_content_selector":           deprecated.ResourceContentSelector(),
-			"nexus_privilege":                  deprecated.ResourcePrivilege(),
-			"nexus_repository":                 deprecated.ResourceRepository(),
-			"nexus_repository_apt_hosted":      repository.ResourceRepositoryAptHosted(),
-			"nexus_repository_apt_proxy":       repository.ResourceRepositoryAptProxy(),
-			"nexus_repository_bower_group":     repository.ResourceRepositoryBowerGroup(),
-			"nexus_repository_bower_hosted":    repository.ResourceRepositoryBowerHosted(),
-			"nexus_repository_bower_proxy":     repository.ResourceRepositoryBowerProxy(),
-			"nexus_repository_cocoapods_proxy": repository.ResourceRepositoryCocoapodsProxy(),
-			"nexus_repository_conan_proxy":     repository.ResourceRepositoryConanProxy(),
-			"nexus_repository_conda_proxy":     repository.ResourceRepositoryCondaProxy(),
-			"nexus_repository_docker_group":    repository.ResourceRepositoryDockerGroup(),
-			"nexus_repository_docker_hosted":   repository.ResourceRepositoryDockerHosted(),
-			"nexus_repository_docker_proxy":    repository.ResourceRepositoryDockerProxy(),
-			"nexus_repository_gitlfs_hosted":   repository.ResourceRepositoryGitlfsHosted(),
-			"nexus_repository_go_group":        repository.ResourceRepositoryGoGroup(),
-			"nexus_repository_go_proxy":        repository.ResourceRepositoryGoProxy(),
-			"nexus_repository_helm_hosted":     repository.ResourceRepositoryHelmHosted(),
-			"nexus_repository_helm_proxy":      repository.ResourceRepositoryHelmProxy(),
-			"nexus_repository_maven_group":     repository.ResourceRepositoryMavenGroup(),
-			"nexus_repository_maven_hosted":    repository.ResourceRepositoryMavenHosted(),
-			"nexus_repository_maven_proxy":     repository.ResourceRepositoryMavenProxy(),
-			"nexus_repository_npm_group":       repository.ResourceRepositoryNpmGroup(),
-			"nexus_repository_npm_hosted":      repository.ResourceRepositoryNpmHosted(),
-			"nexus_repository_npm_proxy":       repository.ResourceRepositoryNpmProxy(),
-			"nexus_repository_nuget_group":     repository.ResourceRepositoryNugetGroup(),
-			"nexus_repository_nuget_hosted":    repository.ResourceRepositoryNugetHosted(),
-			"nexus_repository_nuget_proxy":     repository.ResourceRepositoryNugetProxy(),
-			"nexus_repository_p2_proxy":        repository.ResourceRepositoryP2Proxy(),
-			"nexus_repository_pypi_group":      repository.ResourceRepositoryPypiGroup(),
-			"nexus_repository_pypi_hosted":     repository.ResourceRepositoryPypiHosted(),
-			"nexus_repository_pypi_proxy":      repository.ResourceRepositoryPypiProxy(),
-			"nexus_repository_r_group":         repository.ResourceRepositoryRGroup(),
-			"nexus_repository_r_hosted":        repository.ResourceRepositoryRHosted(),
-			"nexus_repository_r_proxy":         repository.ResourceRepositoryRProxy(),
-			"nexus_repository_raw_group":       repository.ResourceRepositoryRawGroup(),
-			"nexus_repository_raw_hosted":      repository.ResourceRepositoryRawHosted(),
-			"nexus_repository_raw_proxy":       repository.ResourceRepositoryRawProxy(),
-			"nexus_repository_rubygems_group":  repository.ResourceRepositoryRubygemsGroup(),
-			"nexus_repository_rubygems_hosted": repository.ResourceRepositoryRubygemsHosted(),
-			"nexus_repository_rubygems_proxy":  repository.ResourceRepositoryRubygemsProxy(),
-			"nexus_repository_yum_group":       repository.ResourceRepositoryYumGroup(),
-			"nexus_repository_yum_hosted":      repository.ResourceRepositoryYumHosted(),
-			"nexus_repository_yum_proxy":       repository.ResourceRepositoryYumProxy(),
-			"nexus_role":                       deprecated.ResourceRole(),
-			"nexus_routing_rule":               other.ResourceRoutingRule(),
-			"nexus_script":                     other.ResourceScript(),
-			"nexus_security_anonymous":         security.ResourceSecurityAnonymous(),
-			"nexus_security_content_selector":  security.ResourceSecurityContentSelector(),
-			"nexus_security_ldap":              security.ResourceSecurityLDAP(),
-			"nexus_security_ldap_order":        security.ResourceSecurityLDAPOrder(),
-			"nexus_security_realms":            security.ResourceSecurityRealms(),
-			"nexus_security_role":              security.ResourceSecurityRole(),
-			"nexus_security_saml":              security.ResourceSecuritySAML(),
-			"nexus_security_user":              security.ResourceSecurityUser(),
-			"nexus_security_user_token":        security.ResourceSecurityUserToken(),
-			"nexus_user":                       deprecated.ResourceUser(),
+			"nexus_anonymous":                             deprecated.ResourceAnonymous(),
+			"nexus_blobstore":                             deprecated.ResourceBlobstore(),
+			"nexus_blobstore_azure":                       blobstore.ResourceBlobstoreAzure(),
+			"nexus_blobstore_file":                        blobstore.ResourceBlobstoreFile(),
+			"nexus_blobstore_group":                       blobstore.ResourceBlobstoreGroup(),
+			"nexus_blobstore_s3":                          blobstore.ResourceBlobstoreS3(),
+			"nexus_content_selector":                      deprecated.ResourceContentSelector(),
+			"nexus_privilege":                             deprecated.ResourcePrivilege(),
+			"nexus_privilege_application":                 security.ResourcePrivilegeApplication(),
+			"nexus_privilege_repository_admin":            security.ResourcePrivilegeRepositoryAdmin(),
+			"nexus_privilege_repository_content_selector": security.ResourcePrivilegeRepositoryContentSelector(),
+			"nexus_privilege_repository_view":             security.ResourcePrivilegeRepositoryView(),
+			"nexus_privilege_script":                      security.ResourcePrivilegeScript(),
+			"nexus_privilege_wildcard":                    security.ResourcePrivilegeWildcard(),
+			"nexus_repository":                            deprecated.ResourceRepository(),
+			"nexus_repository_apt_hosted":                 repository.ResourceRepositoryAptHosted(),
+			"nexus_repository_apt_proxy":                  repository.ResourceRepositoryAptProxy(),
+			"nexus_repository_bower_group":                repository.ResourceRepositoryBowerGroup(),
+			"nexus_repository_bower_hosted":               repository.ResourceRepositoryBowerHosted(),
+			"nexus_repository_bower_proxy":                repository.ResourceRepositoryBowerProxy(),
+			"nexus_repository_cache_invalidation":         repository.ResourceRepositoryCacheInvalidation(),
+			"nexus_repository_cargo_group":                repository.ResourceRepositoryCargoGroup(),
+			"nexus_repository_cargo_hosted":               repository.ResourceRepositoryCargoHosted(),
+			"nexus_repository_cargo_proxy":                repository.ResourceRepositoryCargoProxy(),
+			"nexus_repository_cocoapods_proxy":            repository.ResourceRepositoryCocoapodsProxy(),
+			"nexus_repository_composer_proxy":             repository.ResourceRepositoryComposerProxy(),
+			"nexus_repository_conan_hosted":               repository.ResourceRepositoryConanHosted(),
+			"nexus_repository_conan_proxy":                repository.ResourceRepositoryConanProxy(),
+			"nexus_repository_conda_proxy":                repository.ResourceRepositoryCondaProxy(),
+			"nexus_repository_docker_group":               repository.ResourceRepositoryDockerGroup(),
+			"nexus_repository_docker_hosted":              repository.ResourceRepositoryDockerHosted(),
+			"nexus_repository_docker_proxy":               repository.ResourceRepositoryDockerProxy(),
+			"nexus_repository_firewall":                   repository.ResourceRepositoryFirewall(),
+			"nexus_repository_gitlfs_hosted":              repository.ResourceRepositoryGitlfsHosted(),
+			"nexus_repository_go_group":                   repository.ResourceRepositoryGoGroup(),
+			"nexus_repository_go_proxy":                   repository.ResourceRepositoryGoProxy(),
+			"nexus_repository_group_membership":           repository.ResourceRepositoryGroupMembership(),
+			"nexus_repository_health_check":               repository.ResourceRepositoryHealthCheck(),
+			"nexus_repository_helm_hosted":                repository.ResourceRepositoryHelmHosted(),
+			"nexus_repository_helm_proxy":                 repository.ResourceRepositoryHelmProxy(),
+			"nexus_repository_index_rebuild":              repository.ResourceRepositoryIndexRebuild(),
+			"nexus_repository_maven_group":                repository.ResourceRepositoryMavenGroup(),
+			"nexus_repository_maven_hosted":               repository.ResourceRepositoryMavenHosted(),
+			"nexus_repository_maven_proxy":                repository.ResourceRepositoryMavenProxy(),
+			"nexus_repository_npm_group":                  repository.ResourceRepositoryNpmGroup(),
+			"nexus_repository_npm_hosted":                 repository.ResourceRepositoryNpmHosted(),
+			"nexus_repository_npm_proxy":                  repository.ResourceRepositoryNpmProxy(),
+			"nexus_repository_nuget_group":                repository.ResourceRepositoryNugetGroup(),
+			"nexus_repository_nuget_hosted":               repository.ResourceRepositoryNugetHosted(),
+			"nexus_repository_nuget_proxy":                repository.ResourceRepositoryNugetProxy(),
+			"nexus_repository_p2_proxy":                   repository.ResourceRepositoryP2Proxy(),
+			"nexus_repository_pypi_group":                 repository.ResourceRepositoryPypiGroup(),
+			"nexus_repository_pypi_hosted":                repository.ResourceRepositoryPypiHosted(),
+			"nexus_repository_pypi_proxy":                 repository.ResourceRepositoryPypiProxy(),
+			"nexus_repository_r_group":                    repository.ResourceRepositoryRGroup(),
+			"nexus_repository_r_hosted":                   repository.ResourceRepositoryRHosted(),
+			"nexus_repository_r_proxy":                    repository.ResourceRepositoryRProxy(),
+			"nexus_repository_raw_group":                  repository.ResourceRepositoryRawGroup(),
+			"nexus_repository_raw_hosted":                 repository.ResourceRepositoryRawHosted(),
+			"nexus_repository_raw_proxy":                  repository.ResourceRepositoryRawProxy(),
+			"nexus_repository_rubygems_group":             repository.ResourceRepositoryRubygemsGroup(),
+			"nexus_repository_rubygems_hosted":            repository.ResourceRepositoryRubygemsHosted(),
+			"nexus_repository_rubygems_proxy":             repository.ResourceRepositoryRubygemsProxy(),
+			"nexus_repository_yum_group":                  repository.ResourceRepositoryYumGroup(),
+			"nexus_repository_yum_hosted":                 repository.ResourceRepositoryYumHosted(),
+			"nexus_repository_yum_proxy":                  repository.ResourceRepositoryYumProxy(),
+			"nexus_role":                                  deprecated.ResourceRole(),
+			"nexus_routing_rule":                          other.ResourceRoutingRule(),
+			"nexus_script":                                other.ResourceScript(),
+			"nexus_security_admin_password":               security.ResourceSecurityAdminPassword(),
+			"nexus_security_anonymous":                    security.ResourceSecurityAnonymous(),
+			"nexus_security_content_selector":             security.ResourceSecurityContentSelector(),
+			"nexus_security_crowd":                        security.ResourceSecurityCrowd(),
+			"nexus_security_default_role":                 security.ResourceSecurityDefaultRole(),
+			"nexus_security_external_role_mapping":        security.ResourceSecurityExternalRoleMapping(),
+			"nexus_security_ldap":                         security.ResourceSecurityLDAP(),
+			"nexus_security_ldap_order":                   security.ResourceSecurityLDAPOrder(),
+			"nexus_security_realm_activation":             security.ResourceSecurityRealmActivation(),
+			"nexus_security_realms":                       security.ResourceSecurityRealms(),
+			"nexus_security_role":                         security.ResourceSecurityRole(),
+			"nexus_security_role_privileges":              security.ResourceSecurityRolePrivileges(),
+			"nexus_security_saml":                         security.ResourceSecuritySAML(),
+			"nexus_security_ssl_truststore":               security.ResourceSecuritySSLTruststore(),
+			"nexus_security_user":                         security.ResourceSecurityUser(),
+			"nexus_security_user_role":                    security.ResourceSecurityUserRole(),
+			"nexus_security_user_status":                  security.ResourceSecurityUserStatus(),
+			"nexus_security_user_token":                   security.ResourceSecurityUserToken(),
+			"nexus_user":                                  deprecated.ResourceUser(),
 		},
 		Schema: map[string]*schema.Schema{
 			"insecure": {
@@ -180,5 +214,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Username: d.Get("username").(string),
 	}
 
-	return nexus.NewClient(config), nil
+	nexusClient := nexus.NewClient(config)
+	nexus3.RegisterConfig(nexusClient, config)
+
+	return nexusClient, nil
 }
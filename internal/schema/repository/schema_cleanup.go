@@ -14,7 +14,7 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"policy_names": {
-					Description: "List of policy names",
+					Description: "List of policy names. All policies must exist",
 					Elem: &schema.Schema{
 						Type: schema.TypeString,
 					},
@@ -27,6 +27,11 @@ var (
 					Optional:    true,
 					Type:        schema.TypeInt,
 				},
+				"subdomain": {
+					Description: "Pro-only: Allows to use repository name as subdomain",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
 				"v1_enabled": {
 					Description: "Whether to allow clients to use the V1 API to interact with this repository",
 					Required:    true,
@@ -56,6 +61,11 @@ var (
 					Computed:    true,
 					Type:        schema.TypeInt,
 				},
+				"subdomain": {
+					Description: "Pro-only: Allows to use repository name as subdomain",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
 				"v1_enabled": {
 					Description: "Whether to allow clients to use the V1 API to interact with this repository",
 					Computed:    true,
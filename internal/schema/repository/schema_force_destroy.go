@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceForceDestroy = &schema.Schema{
+		Description: "Delete the repository even if it still contains components. If `false`, deleting a repository with components fails",
+		Optional:    true,
+		Default:     false,
+		Type:        schema.TypeBool,
+	}
+)
@@ -12,16 +12,14 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"member_names": {
-					Description: "Member repositories names",
+					Description: "Member repositories names. The order of the members defines the order in which they are searched",
 					Elem: &schema.Schema{
-						Type: schema.TypeString,
+						DiffSuppressFunc: suppressMemberNameCase,
+						Type:             schema.TypeString,
 					},
 					MinItems: 1,
 					Required: true,
-					Set: func(v interface{}) int {
-						return schema.HashString(strings.ToLower(v.(string)))
-					},
-					Type: schema.TypeSet,
+					Type:     schema.TypeList,
 				},
 			},
 		},
@@ -34,16 +32,14 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"member_names": {
-					Description: "Member repositories names",
+					Description: "Member repositories names. The order of the members defines the order in which they are searched",
 					Elem: &schema.Schema{
-						Type: schema.TypeString,
+						DiffSuppressFunc: suppressMemberNameCase,
+						Type:             schema.TypeString,
 					},
 					MinItems: 1,
 					Required: true,
-					Set: func(v interface{}) int {
-						return schema.HashString(strings.ToLower(v.(string)))
-					},
-					Type: schema.TypeSet,
+					Type:     schema.TypeList,
 				},
 				"writable_member": {
 					Description: "Pro-only: This field is for the Group Deployment feature available in NXRM Pro.",
@@ -61,12 +57,12 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"member_names": {
-					Description: "Member repositories names",
+					Description: "Member repositories names in search order",
 					Elem: &schema.Schema{
 						Type: schema.TypeString,
 					},
 					Computed: true,
-					Type:     schema.TypeSet,
+					Type:     schema.TypeList,
 				},
 			},
 		},
@@ -78,12 +74,12 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"member_names": {
-					Description: "Member repositories names",
+					Description: "Member repositories names in search order",
 					Elem: &schema.Schema{
 						Type: schema.TypeString,
 					},
 					Computed: true,
-					Type:     schema.TypeSet,
+					Type:     schema.TypeList,
 				},
 				"writable_member": {
 					Description: "Pro-only: This field is for the Group Deployment feature available in NXRM Pro.",
@@ -96,3 +92,10 @@ var (
 		Type:     schema.TypeList,
 	}
 )
+
+// suppressMemberNameCase ignores the case of member names, because Nexus
+// treats repository names case-insensitively and may return a member with
+// another case than configured
+func suppressMemberNameCase(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
@@ -132,10 +132,10 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"type": {
-					Description:      "Authentication type. Possible values: `ntlm` or `username`",
+					Description:      "Authentication type. Possible values: `ntlm`, `username` or `bearerToken`",
 					Required:         true,
 					Type:             schema.TypeString,
-					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ntlm", "username"}, false)),
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ntlm", "username", "bearerToken"}, false)),
 				},
 				"username": {
 					Description: "The username used by the proxy repository",
@@ -148,6 +148,12 @@ var (
 					Sensitive:   true,
 					Type:        schema.TypeString,
 				},
+				"bearer_token": {
+					Description: "The bearer token used by the proxy repository",
+					Optional:    true,
+					Sensitive:   true,
+					Type:        schema.TypeString,
+				},
 				"ntlm_domain": {
 					Description: "The ntlm domain to connect",
 					Optional:    true,
@@ -170,10 +176,10 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"type": {
-					Description:  "Authentication type. Possible values: `ntlm` or `username`",
+					Description:  "Authentication type. Possible values: `ntlm`, `username` or `bearerToken`",
 					Required:     true,
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{"ntlm", "username"}, false),
+					ValidateFunc: validation.StringInSlice([]string{"ntlm", "username", "bearerToken"}, false),
 				},
 				"username": {
 					Description: "The username used by the proxy repository",
@@ -186,6 +192,12 @@ var (
 					Sensitive:   true,
 					Type:        schema.TypeString,
 				},
+				"bearer_token": {
+					Description: "The bearer token used by the proxy repository",
+					Optional:    true,
+					Sensitive:   true,
+					Type:        schema.TypeString,
+				},
 				"ntlm_domain": {
 					Description: "The ntlm domain to connect",
 					Optional:    true,
@@ -296,7 +308,7 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"type": {
-					Description: "Authentication type. Possible values: `ntlm` or `username`",
+					Description: "Authentication type. Possible values: `ntlm`, `username` or `bearerToken`",
 					Computed:    true,
 					Type:        schema.TypeString,
 				},
@@ -311,6 +323,12 @@ var (
 					Sensitive:   true,
 					Type:        schema.TypeString,
 				},
+				"bearer_token": {
+					Description: "The bearer token used by the proxy repository",
+					Computed:    true,
+					Sensitive:   true,
+					Type:        schema.TypeString,
+				},
 				"ntlm_domain": {
 					Description: "The ntlm domain to connect",
 					Computed:    true,
@@ -332,7 +350,7 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"type": {
-					Description: "Authentication type. Possible values: `ntlm` or `username`",
+					Description: "Authentication type. Possible values: `ntlm`, `username` or `bearerToken`",
 					Computed:    true,
 					Type:        schema.TypeString,
 				},
@@ -347,6 +365,12 @@ var (
 					Sensitive:   true,
 					Type:        schema.TypeString,
 				},
+				"bearer_token": {
+					Description: "The bearer token used by the proxy repository",
+					Computed:    true,
+					Sensitive:   true,
+					Type:        schema.TypeString,
+				},
 				"ntlm_domain": {
 					Description: "The ntlm domain to connect",
 					Computed:    true,
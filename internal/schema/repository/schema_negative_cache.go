@@ -7,6 +7,7 @@ import (
 var (
 	ResourceNegativeCache = &schema.Schema{
 		Description: "Configuration of the negative cache handling",
+		Computed:    true,
 		Optional:    true,
 		Type:        schema.TypeList,
 		MaxItems:    1,
@@ -19,10 +20,11 @@ var (
 					Type:        schema.TypeBool,
 				},
 				"ttl": {
-					Default:     1440,
-					Description: "How long to cache the fact that a file was not found in the repository (in minutes)",
-					Optional:    true,
-					Type:        schema.TypeInt,
+					Default:          1440,
+					Description:      "How long to cache the fact that a file was not found in the repository (in minutes). New repositories default to 1440. If not configured, the current value of an existing repository is kept, also after the attribute is removed from the configuration",
+					DiffSuppressFunc: suppressUnconfiguredServerDefault,
+					Optional:         true,
+					Type:             schema.TypeInt,
 				},
 			},
 		},
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,16 +14,18 @@ var (
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"content_max_age": {
-					Description: "How long (in minutes) to cache artifacts before rechecking the remote repository",
-					Type:        schema.TypeInt,
-					Optional:    true,
-					Default:     1440,
+					Description:      "How long (in minutes) to cache artifacts before rechecking the remote repository. New repositories default to 1440. If not configured, the current value of an existing repository is kept, also after the attribute is removed from the configuration",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          1440,
+					DiffSuppressFunc: suppressUnconfiguredServerDefault,
 				},
 				"metadata_max_age": {
-					Description: "How long (in minutes) to cache metadata before rechecking the remote repository.",
-					Type:        schema.TypeInt,
-					Optional:    true,
-					Default:     1440,
+					Description:      "How long (in minutes) to cache metadata before rechecking the remote repository. New repositories default to 1440. If not configured, the current value of an existing repository is kept, also after the attribute is removed from the configuration.",
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          1440,
+					DiffSuppressFunc: suppressUnconfiguredServerDefault,
 				},
 				"remote_url": {
 					Description: "Location of the remote repository being proxied",
@@ -57,3 +60,13 @@ var (
 		},
 	}
 )
+
+// suppressUnconfiguredServerDefault keeps the value Nexus has chosen for an
+// attribute which is not set in the configuration. The defaults differ per
+// format, so statically defined schema defaults would cause perpetual diffs
+// for imported repositories. The state does not tell where a value came from,
+// so a value which was configured before is kept as well when the attribute
+// is removed from the configuration.
+func suppressUnconfiguredServerDefault(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && !tools.IsAttributeConfigured(d.GetRawConfig(), k)
+}
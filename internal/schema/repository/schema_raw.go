@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	ResourceRawContentDisposition = &schema.Schema{
+		Description: "Add Content-Disposition header as 'Attachment' to force downloads instead of displaying content inline in a browser. Possible Value: `INLINE` or `ATTACHMENT`",
+		Default:     string(repository.RawContentDispositionAttachment),
+		Optional:    true,
+		Type:        schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(repository.RawContentDispositionInline),
+			string(repository.RawContentDispositionAttachment),
+		}, false),
+	}
+	DataSourceRawContentDisposition = &schema.Schema{
+		Description: "Add Content-Disposition header as 'Attachment' to force downloads instead of displaying content inline in a browser",
+		Computed:    true,
+		Type:        schema.TypeString,
+	}
+)
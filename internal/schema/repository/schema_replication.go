@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceReplication = &schema.Schema{
+		Description: "Pro-only: Pull replication configuration",
+		MaxItems:    1,
+		Optional:    true,
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"asset_path_regex": {
+					Description: "Regular expression of the asset paths to replicate",
+					Optional:    true,
+					Type:        schema.TypeString,
+				},
+				"preemptive_pull_enabled": {
+					Description: "Whether pre-emptive pull of replicated content is enabled",
+					Default:     false,
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+			},
+		},
+	}
+	DataSourceReplication = &schema.Schema{
+		Description: "Pro-only: Pull replication configuration",
+		Computed:    true,
+		Type:        schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"asset_path_regex": {
+					Description: "Regular expression of the asset paths to replicate",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"preemptive_pull_enabled": {
+					Description: "Whether pre-emptive pull of replicated content is enabled",
+					Computed:    true,
+					Type:        schema.TypeBool,
+				},
+			},
+		},
+	}
+)
@@ -110,4 +110,71 @@ var (
 			},
 		},
 	}
+
+	ResourceDockerHostedStorage = &schema.Schema{
+		Description: "The storage configuration of the repository",
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"blob_store_name": {
+					Description: "Blob store used to store repository contents",
+					Required:    true,
+					Type:        schema.TypeString,
+				},
+				"strict_content_type_validation": {
+					Description: "Whether to validate uploaded content's MIME type appropriate for the repository format",
+					Required:    true,
+					Type:        schema.TypeBool,
+				},
+				"write_policy": {
+					Description: "Controls if deployments of and updates to assets are allowed",
+					Default:     "ALLOW",
+					Optional:    true,
+					Type:        schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"ALLOW",
+						"ALLOW_ONCE",
+						"DENY",
+					}, false),
+				},
+				"latest_policy": {
+					Description: "Whether to allow redeploying the 'latest' tag but defer to the write policy for all other tags",
+					Default:     false,
+					Optional:    true,
+					Type:        schema.TypeBool,
+				},
+			},
+		},
+	}
+	DataSourceDockerHostedStorage = &schema.Schema{
+		Description: "The storage configuration of the repository",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"blob_store_name": {
+					Description: "Blob store used to store repository contents",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"strict_content_type_validation": {
+					Description: "Whether to validate uploaded content's MIME type appropriate for the repository format",
+					Computed:    true,
+					Type:        schema.TypeBool,
+				},
+				"write_policy": {
+					Description: "Controls if deployments of and updates to assets are allowed",
+					Computed:    true,
+					Type:        schema.TypeString,
+				},
+				"latest_policy": {
+					Description: "Whether to allow redeploying the 'latest' tag but defer to the write policy for all other tags",
+					Computed:    true,
+					Type:        schema.TypeBool,
+				},
+			},
+		},
+	}
 )
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ResourceURL = &schema.Schema{
+		Description: "The URL of the repository",
+		Computed:    true,
+		Type:        schema.TypeString,
+	}
+	DataSourceURL = ResourceURL
+)
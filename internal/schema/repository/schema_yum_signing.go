@@ -6,7 +6,7 @@ import (
 
 var (
 	ResourceYumSigning = &schema.Schema{
-		Description: "Contains signing data of repositores",
+		Description: "Contains signing data of repositories",
 		Type:        schema.TypeList,
 		Optional:    true,
 		MaxItems:    1,
@@ -28,7 +28,7 @@ var (
 		},
 	}
 	DataSourceYumSigning = &schema.Schema{
-		Description: "Contains signing data of repositores",
+		Description: "Contains signing data of repositories",
 		Type:        schema.TypeList,
 		Computed:    true,
 		Elem: &schema.Resource{
@@ -8,6 +8,7 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -18,11 +19,12 @@ func ResourceBlobstoreAzure() *schema.Resource {
 
 Use this resource to create a Nexus Azure blobstore.`,
 
-		Create: resourceBlobstoreAzureCreate,
-		Read:   resourceBlobstoreAzureRead,
-		Update: resourceBlobstoreAzureUpdate,
-		Delete: resourceBlobstoreAzureDelete,
-		Exists: resourceBlobstoreAzureExists,
+		CustomizeDiff: tools.RegisterPlannedDiff(tools.PlannedBlobStore, "name"),
+		Create:        resourceBlobstoreAzureCreate,
+		Read:          resourceBlobstoreAzureRead,
+		Update:        resourceBlobstoreAzureUpdate,
+		Delete:        resourceBlobstoreAzureDelete,
+		Exists:        resourceBlobstoreAzureExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -8,6 +8,7 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,11 +16,12 @@ func ResourceBlobstoreFile() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus file blobstore.",
 
-		Create: resourceBlobstoreFileCreate,
-		Read:   resourceBlobstoreFileRead,
-		Update: resourceBlobstoreFileUpdate,
-		Delete: resourceBlobstoreFileDelete,
-		Exists: resourceBlobstoreFileExists,
+		CustomizeDiff: tools.RegisterPlannedDiff(tools.PlannedBlobStore, "name"),
+		Create:        resourceBlobstoreFileCreate,
+		Read:          resourceBlobstoreFileRead,
+		Update:        resourceBlobstoreFileUpdate,
+		Delete:        resourceBlobstoreFileDelete,
+		Exists:        resourceBlobstoreFileExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
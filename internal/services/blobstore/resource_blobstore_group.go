@@ -19,11 +19,12 @@ func ResourceBlobstoreGroup() *schema.Resource {
 
 Use this resource to create a Nexus group blobstore.`,
 
-		Create: resourceBlobstoreGroupCreate,
-		Read:   resourceBlobstoreGroupRead,
-		Update: resourceBlobstoreGroupUpdate,
-		Delete: resourceBlobstoreGroupDelete,
-		Exists: resourceBlobstoreGroupExists,
+		CustomizeDiff: tools.RegisterPlannedDiff(tools.PlannedBlobStore, "name"),
+		Create:        resourceBlobstoreGroupCreate,
+		Read:          resourceBlobstoreGroupRead,
+		Update:        resourceBlobstoreGroupUpdate,
+		Delete:        resourceBlobstoreGroupDelete,
+		Exists:        resourceBlobstoreGroupExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	blobstoreSchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	internalTools "github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,11 +18,12 @@ func ResourceBlobstoreS3() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus S3 blobstore.",
 
-		Create: resourceBlobstoreS3Create,
-		Read:   resourceBlobstoreS3Read,
-		Update: resourceBlobstoreS3Update,
-		Delete: resourceBlobstoreS3Delete,
-		Exists: resourceBlobstoreS3Exists,
+		CustomizeDiff: internalTools.RegisterPlannedDiff(internalTools.PlannedBlobStore, "name"),
+		Create:        resourceBlobstoreS3Create,
+		Read:          resourceBlobstoreS3Read,
+		Update:        resourceBlobstoreS3Update,
+		Delete:        resourceBlobstoreS3Delete,
+		Exists:        resourceBlobstoreS3Exists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/blobstore"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	internalTools "github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -20,11 +21,12 @@ func ResourceBlobstore() *schema.Resource {
 
 Use this resource to create a Nexus blobstore.`,
 
-		Create: resourceBlobstoreCreate,
-		Read:   resourceBlobstoreRead,
-		Update: resourceBlobstoreUpdate,
-		Delete: resourceBlobstoreDelete,
-		Exists: resourceBlobstoreExists,
+		CustomizeDiff: internalTools.RegisterPlannedDiff(internalTools.PlannedBlobStore, "name"),
+		Create:        resourceBlobstoreCreate,
+		Read:          resourceBlobstoreRead,
+		Update:        resourceBlobstoreUpdate,
+		Delete:        resourceBlobstoreDelete,
+		Exists:        resourceBlobstoreExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
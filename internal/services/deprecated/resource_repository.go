@@ -1,11 +1,15 @@
 package deprecated
 
 import (
+	"context"
+	"fmt"
 	"strings"
 
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,11 +28,12 @@ Use this resource to create a Nexus Repository.`,
 		Delete: resourceRepositoryDelete,
 		Exists: resourceRepositoryExists,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importRepositoryState,
 		},
 
 		Schema: map[string]*schema.Schema{
-			"id": common.ResourceID,
+			"id":            common.ResourceID,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
 			"format": {
 				Description:  "Repository format. Possible values: `apt`, `bower`, `conan`, `docker`, `gitlfs`, `go`, `helm`, `maven2`, `npm`, `nuget`, `p2`, `pypi`, `raw`, `rubygems`, `yum`",
 				ForceNew:     true,
@@ -1027,6 +1032,18 @@ func resourceRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceRepositoryDelete(d *schema.ResourceData, m interface{}) error {
+	// Proxy repositories only hold cached components and group repositories
+	// hold none
+	if d.Get("type").(string) == repository.RepositoryTypeHosted && !d.Get("force_destroy").(bool) {
+		hasComponents, err := nexus3.NewClient(m.(*nexus.NexusClient)).Component.HasComponents(d.Id())
+		if err != nil {
+			return err
+		}
+		if hasComponents {
+			return fmt.Errorf("repository '%s' still contains components. Set force_destroy to true and apply before destroying it", d.Id())
+		}
+	}
+
 	client := m.(*nexus.NexusClient)
 
 	return client.Repository.Legacy.Delete(d.Id())
@@ -1038,3 +1055,12 @@ func resourceRepositoryExists(d *schema.ResourceData, m interface{}) (bool, erro
 	repo, err := client.Repository.Legacy.Get(d.Id())
 	return repo != nil, err
 }
+
+// importRepositoryState sets the default of force_destroy, which is not
+// part of the API, to avoid a diff after import
+func importRepositoryState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("force_destroy", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
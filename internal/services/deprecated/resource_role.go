@@ -17,11 +17,12 @@ func ResourceRole() *schema.Resource {
 
 Use this resource to create a Nexus Role.`,
 
-		Create: resourceRoleCreate,
-		Read:   resourceRoleRead,
-		Update: resourceRoleUpdate,
-		Delete: resourceRoleDelete,
-		Exists: resourceRoleExists,
+		CustomizeDiff: tools.RegisterPlannedDiff(tools.PlannedRole, "roleid"),
+		Create:        resourceRoleCreate,
+		Read:          resourceRoleRead,
+		Update:        resourceRoleUpdate,
+		Delete:        resourceRoleDelete,
+		Exists:        resourceRoleExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -16,6 +16,7 @@ func DataSourceRepositoryAptHosted() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repository.DataSourceName,
 			"online": repository.DataSourceOnline,
+			"url":    repository.DataSourceURL,
 			// Hosted schemas
 			"cleanup":   repository.DataSourceCleanup,
 			"component": repository.DataSourceComponent,
@@ -17,11 +17,13 @@ func DataSourceRepositoryAptProxy() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repositorySchema.DataSourceName,
 			"online": repositorySchema.DataSourceOnline,
+			"url":    repositorySchema.DataSourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.DataSourceCleanup,
 			"http_client":    repositorySchema.DataSourceHTTPClient,
 			"negative_cache": repositorySchema.DataSourceNegativeCache,
 			"proxy":          repositorySchema.DataSourceProxy,
+			"replication":    repositorySchema.DataSourceReplication,
 			"routing_rule":   repositorySchema.DataSourceRoutingRule,
 			"storage":        repositorySchema.DataSourceStorage,
 			// Apt proxy schemas
@@ -31,7 +33,7 @@ func DataSourceRepositoryAptProxy() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"flat": {
-				Description: "Distribution to fetch",
+				Description: "Whether this repository is flat",
 				Computed:    true,
 				Type:        schema.TypeBool,
 			},
@@ -17,11 +17,13 @@ func DataSourceRepositoryBowerProxy() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repositorySchema.DataSourceName,
 			"online": repositorySchema.DataSourceOnline,
+			"url":    repositorySchema.DataSourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.DataSourceCleanup,
 			"http_client":    repositorySchema.DataSourceHTTPClient,
 			"negative_cache": repositorySchema.DataSourceNegativeCache,
 			"proxy":          repositorySchema.DataSourceProxy,
+			"replication":    repositorySchema.DataSourceReplication,
 			"routing_rule":   repositorySchema.DataSourceRoutingRule,
 			"storage":        repositorySchema.DataSourceStorage,
 			// Bower proxy schemas
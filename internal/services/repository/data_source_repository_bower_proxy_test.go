@@ -7,6 +7,7 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -19,7 +20,7 @@ data "nexus_repository_bower_proxy" "acceptance" {
 }
 
 func TestAccDataSourceRepositoryBowerProxy(t *testing.T) {
-	repoUsingDefaults := repository.BowerProxyRepository{
+	repoUsingDefaults := nexus3.BowerProxyRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
 		Proxy: repository.Proxy{
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceRepositoryCargoGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get an existing cargo group repository.",
+
+		Read: dataSourceRepositoryCargoGroupRead,
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.DataSourceID,
+			"name":   repository.DataSourceName,
+			"online": repository.DataSourceOnline,
+			"url":    repository.DataSourceURL,
+			// Group schemas
+			"group":   repository.DataSourceGroupDeploy,
+			"storage": repository.DataSourceStorage,
+		},
+	}
+}
+
+func dataSourceRepositoryCargoGroupRead(resourceData *schema.ResourceData, m interface{}) error {
+	resourceData.SetId(resourceData.Get("name").(string))
+
+	return resourceCargoGroupRepositoryRead(resourceData, m)
+}
@@ -17,13 +17,21 @@ func DataSourceRepositoryConanProxy() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repositorySchema.DataSourceName,
 			"online": repositorySchema.DataSourceOnline,
+			"url":    repositorySchema.DataSourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.DataSourceCleanup,
 			"http_client":    repositorySchema.DataSourceHTTPClient,
 			"negative_cache": repositorySchema.DataSourceNegativeCache,
 			"proxy":          repositorySchema.DataSourceProxy,
+			"replication":    repositorySchema.DataSourceReplication,
 			"routing_rule":   repositorySchema.DataSourceRoutingRule,
 			"storage":        repositorySchema.DataSourceStorage,
+			// Conan proxy schemas
+			"protocol_version": {
+				Description: "Conan protocol version served by the repository",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
 		},
 	}
 }
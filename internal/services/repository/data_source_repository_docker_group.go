@@ -8,7 +8,7 @@ import (
 
 func DataSourceRepositoryDockerGroup() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this data source to get an existing docker repository.",
+		Description: "Use this data source to get an existing docker group repository.",
 
 		Read: dataSourceRepositoryDockerGroupRead,
 		Schema: map[string]*schema.Schema{
@@ -16,6 +16,7 @@ func DataSourceRepositoryDockerGroup() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repository.DataSourceName,
 			"online": repository.DataSourceOnline,
+			"url":    repository.DataSourceURL,
 			// Group schemas
 			"group":   repository.DataSourceGroupDeploy,
 			"storage": repository.DataSourceStorage,
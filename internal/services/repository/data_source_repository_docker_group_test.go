@@ -7,6 +7,7 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -20,14 +21,14 @@ data "nexus_repository_docker_group" "acceptance" {
 
 func TestAccDataSourceRepositoryDockerGroup(t *testing.T) {
 	repoHosted := testAccResourceRepositoryDockerHosted()
-	repoGroup := repository.DockerGroupRepository{
+	repoGroup := nexus3.DockerGroupRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
 			StrictContentTypeValidation: false,
 		},
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: true,
 			V1Enabled:      true,
 		},
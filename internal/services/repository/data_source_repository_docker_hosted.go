@@ -8,7 +8,7 @@ import (
 
 func DataSourceRepositoryDockerHosted() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this data source to get an existing docker repository.",
+		Description: "Use this data source to get an existing hosted docker repository.",
 
 		Read: dataSourceRepositoryDockerHostedRead,
 		Schema: map[string]*schema.Schema{
@@ -16,10 +16,11 @@ func DataSourceRepositoryDockerHosted() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repository.DataSourceName,
 			"online": repository.DataSourceOnline,
+			"url":    repository.DataSourceURL,
 			// Hosted schemas
 			"cleanup":   repository.DataSourceCleanup,
 			"component": repository.DataSourceComponent,
-			"storage":   repository.DataSourceHostedStorage,
+			"storage":   repository.DataSourceDockerHostedStorage,
 			// Docker hosted schemas
 			"docker": repository.DataSourceDocker,
 		},
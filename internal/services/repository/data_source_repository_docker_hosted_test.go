@@ -5,8 +5,8 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -19,14 +19,14 @@ data "nexus_repository_docker_hosted" "acceptance" {
 }
 
 func TestAccDataSourceRepositoryDockerHosted(t *testing.T) {
-	repo := repository.DockerHostedRepository{
+	repo := nexus3.DockerHostedRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
-		Storage: repository.HostedStorage{
+		Storage: nexus3.DockerHostedStorage{
 			BlobStoreName:               "default",
 			StrictContentTypeValidation: false,
 		},
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: true,
 			V1Enabled:      true,
 		},
@@ -17,11 +17,13 @@ func DataSourceRepositoryDockerProxy() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repositorySchema.DataSourceName,
 			"online": repositorySchema.DataSourceOnline,
+			"url":    repositorySchema.DataSourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.DataSourceCleanup,
 			"http_client":    repositorySchema.DataSourceHTTPClient,
 			"negative_cache": repositorySchema.DataSourceNegativeCache,
 			"proxy":          repositorySchema.DataSourceProxy,
+			"replication":    repositorySchema.DataSourceReplication,
 			"routing_rule":   repositorySchema.DataSourceRoutingRule,
 			"storage":        repositorySchema.DataSourceStorage,
 			// Docker proxy schemas
@@ -32,6 +34,19 @@ func DataSourceRepositoryDockerProxy() *schema.Resource {
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"cache_foreign_layers": {
+							Description: "Allow Nexus Repository Manager to download and cache foreign layers",
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						"foreign_layer_url_whitelist": {
+							Description: "A set of regular expressions used to identify URLs that are allowed for foreign layer requests",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+							Type:     schema.TypeSet,
+						},
 						"index_type": {
 							Description: "Type of Docker Index",
 							Computed:    true,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -19,14 +20,14 @@ data "nexus_repository_docker_proxy" "acceptance" {
 }
 
 func TestAccDataSourceRepositoryDockerProxy(t *testing.T) {
-	repoUsingDefaults := repository.DockerProxyRepository{
+	repoUsingDefaults := nexus3.DockerProxyRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: true,
 			V1Enabled:      true,
 		},
-		DockerProxy: repository.DockerProxy{
+		DockerProxy: nexus3.DockerProxy{
 			IndexType: repository.DockerProxyIndexTypeHub,
 		},
 		Proxy: repository.Proxy{
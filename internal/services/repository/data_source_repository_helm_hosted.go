@@ -8,7 +8,7 @@ import (
 
 func DataSourceRepositoryHelmHosted() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this data source to get an existing hosted yum repository.",
+		Description: "Use this data source to get an existing hosted helm repository.",
 
 		Read: dataSourceRepositoryHelmHostedRead,
 		Schema: map[string]*schema.Schema{
@@ -16,6 +16,7 @@ func DataSourceRepositoryHelmHosted() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repository.DataSourceName,
 			"online": repository.DataSourceOnline,
+			"url":    repository.DataSourceURL,
 			// Hosted schemas
 			"cleanup":   repository.DataSourceCleanup,
 			"component": repository.DataSourceComponent,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -19,11 +20,11 @@ data "nexus_repository_helm_proxy" "acceptance" {
 }
 
 func TestAccDataSourceRepositoryHelmProxy(t *testing.T) {
-	repoUsingDefaults := repository.HelmProxyRepository{
+	repoUsingDefaults := nexus3.HelmProxyRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
 		Proxy: repository.Proxy{
-			RemoteURL: "https://kubernetes-charts.storage.googleapis.com/",
+			RemoteURL: "https://charts.bitnami.com/bitnami",
 		},
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
@@ -17,13 +17,21 @@ func DataSourceRepositoryPypiProxy() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repositorySchema.DataSourceName,
 			"online": repositorySchema.DataSourceOnline,
+			"url":    repositorySchema.DataSourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.DataSourceCleanup,
 			"http_client":    repositorySchema.DataSourceHTTPClient,
 			"negative_cache": repositorySchema.DataSourceNegativeCache,
 			"proxy":          repositorySchema.DataSourceProxy,
+			"replication":    repositorySchema.DataSourceReplication,
 			"routing_rule":   repositorySchema.DataSourceRoutingRule,
 			"storage":        repositorySchema.DataSourceStorage,
+			// Pypi proxy schemas
+			"remove_quarantined": {
+				Description: "Remove quarantined versions from the simple index of the pypi repository.",
+				Computed:    true,
+				Type:        schema.TypeBool,
+			},
 		},
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -19,12 +20,15 @@ data "nexus_repository_pypi_proxy" "acceptance" {
 }
 
 func TestAccDataSourceRepositoryPypiProxy(t *testing.T) {
-	repoUsingDefaults := repository.PypiProxyRepository{
+	repoUsingDefaults := nexus3.PypiProxyRepository{
 		Name:   fmt.Sprintf("acceptance-%s", acctest.RandString(10)),
 		Online: true,
 		Proxy: repository.Proxy{
 			RemoteURL: "https://pypijs.org/",
 		},
+		Pypi: &nexus3.Pypi{
+			RemoveQuarantined: true,
+		},
 		Storage: repository.Storage{
 			BlobStoreName:               "default",
 			StrictContentTypeValidation: true,
@@ -44,6 +48,7 @@ func TestAccDataSourceRepositoryPypiProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(dataSourceName, "id", repoUsingDefaults.Name),
 						resource.TestCheckResourceAttr(dataSourceName, "name", repoUsingDefaults.Name),
 						resource.TestCheckResourceAttr(dataSourceName, "online", strconv.FormatBool(repoUsingDefaults.Online)),
+						resource.TestCheckResourceAttr(dataSourceName, "remove_quarantined", strconv.FormatBool(repoUsingDefaults.Pypi.RemoveQuarantined)),
 					),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(dataSourceName, "http_client.#", "1"),
@@ -16,9 +16,12 @@ func DataSourceRepositoryRawGroup() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repository.DataSourceName,
 			"online": repository.DataSourceOnline,
+			"url":    repository.DataSourceURL,
 			// Group schemas
 			"group":   repository.DataSourceGroup,
 			"storage": repository.DataSourceStorage,
+			// Raw schemas
+			"content_disposition": repository.DataSourceRawContentDisposition,
 		},
 	}
 }
@@ -16,10 +16,13 @@ func DataSourceRepositoryRawHosted() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repository.DataSourceName,
 			"online": repository.DataSourceOnline,
+			"url":    repository.DataSourceURL,
 			// Hosted schemas
 			"cleanup":   repository.DataSourceCleanup,
 			"component": repository.DataSourceComponent,
 			"storage":   repository.DataSourceHostedStorage,
+			// Raw schemas
+			"content_disposition": repository.DataSourceRawContentDisposition,
 		},
 	}
 }
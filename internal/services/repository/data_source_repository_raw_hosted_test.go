@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -40,6 +41,7 @@ func TestAccDataSourceRepositoryRawHosted(t *testing.T) {
 						resource.TestCheckResourceAttr(dataSourceName, "id", repoUsingDefaults.Name),
 						resource.TestCheckResourceAttr(dataSourceName, "name", repoUsingDefaults.Name),
 						resource.TestCheckResourceAttr(dataSourceName, "online", strconv.FormatBool(repoUsingDefaults.Online)),
+						resource.TestMatchResourceAttr(dataSourceName, "url", regexp.MustCompile("/repository/"+repoUsingDefaults.Name+"$")),
 						resource.TestCheckResourceAttr(dataSourceName, "storage.0.blob_store_name", repoUsingDefaults.Storage.BlobStoreName),
 						resource.TestCheckResourceAttr(dataSourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repoUsingDefaults.Storage.StrictContentTypeValidation)),
 					),
@@ -16,6 +16,7 @@ func DataSourceRepositoryRubygemsGroup() *schema.Resource {
 			"id":     common.DataSourceID,
 			"name":   repository.DataSourceName,
 			"online": repository.DataSourceOnline,
+			"url":    repository.DataSourceURL,
 			// Group schemas
 			"group":   repository.DataSourceGroupDeploy,
 			"storage": repository.DataSourceStorage,
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,7 +18,7 @@ func flattenCleanup(cleanup *repository.Cleanup) []map[string]interface{} {
 	}
 }
 
-func flattenDocker(docker *repository.Docker) []map[string]interface{} {
+func flattenDocker(docker *nexus3.Docker) []map[string]interface{} {
 	data := map[string]interface{}{
 		"force_basic_auth": docker.ForceBasicAuth,
 		"v1_enabled":       docker.V1Enabled,
@@ -29,13 +30,21 @@ func flattenDocker(docker *repository.Docker) []map[string]interface{} {
 	if docker.HTTPSPort != nil {
 		data["https_port"] = *docker.HTTPSPort
 	}
+	if docker.Subdomain != nil {
+		data["subdomain"] = *docker.Subdomain
+	}
 
 	return []map[string]interface{}{data}
 }
 
-func flattenDockerProxy(dockerProxy *repository.DockerProxy) []map[string]interface{} {
+func flattenDockerProxy(dockerProxy *nexus3.DockerProxy) []map[string]interface{} {
 	data := map[string]interface{}{
-		"index_type": string(dockerProxy.IndexType),
+		"foreign_layer_url_whitelist": tools.StringSliceToInterfaceSlice(dockerProxy.ForeignLayerURLWhitelist),
+		"index_type":                  string(dockerProxy.IndexType),
+	}
+
+	if dockerProxy.CacheForeignLayers != nil {
+		data["cache_foreign_layers"] = *dockerProxy.CacheForeignLayers
 	}
 
 	if dockerProxy.IndexURL != nil {
@@ -74,7 +83,7 @@ func flattenGroupDeploy(group *repository.GroupDeploy) []map[string]interface{}
 	return []map[string]interface{}{data}
 }
 
-func flattenHTTPClient(httpClient *repository.HTTPClient, d *schema.ResourceData) []map[string]interface{} {
+func flattenHTTPClient(httpClient *nexus3.HTTPClient, d *schema.ResourceData) []map[string]interface{} {
 	if httpClient == nil {
 		return nil
 	}
@@ -88,7 +97,7 @@ func flattenHTTPClient(httpClient *repository.HTTPClient, d *schema.ResourceData
 	}
 }
 
-func flattenHTTPClientWithPreemptiveAuth(httpClient *repository.HTTPClientWithPreemptiveAuth, d *schema.ResourceData) []map[string]interface{} {
+func flattenHTTPClientWithPreemptiveAuth(httpClient *nexus3.HTTPClientWithPreemptiveAuth, d *schema.ResourceData) []map[string]interface{} {
 	if httpClient == nil {
 		return nil
 	}
@@ -102,33 +111,35 @@ func flattenHTTPClientWithPreemptiveAuth(httpClient *repository.HTTPClientWithPr
 	}
 }
 
-func flattenHTTPClientAuthentication(auth *repository.HTTPClientAuthentication, d *schema.ResourceData) []map[string]interface{} {
+func flattenHTTPClientAuthentication(auth *nexus3.HTTPClientAuthentication, d *schema.ResourceData) []map[string]interface{} {
 	if auth == nil {
 		return nil
 	}
 	return []map[string]interface{}{
 		{
-			"ntlm_domain": auth.NTLMDomain,
-			"ntlm_host":   auth.NTLMHost,
-			"type":        auth.Type,
-			"username":    auth.Username,
-			"password":    d.Get("http_client.0.authentication.0.password").(string),
+			"ntlm_domain":  auth.NTLMDomain,
+			"ntlm_host":    auth.NTLMHost,
+			"type":         auth.Type,
+			"username":     auth.Username,
+			"password":     d.Get("http_client.0.authentication.0.password").(string),
+			"bearer_token": d.Get("http_client.0.authentication.0.bearer_token").(string),
 		},
 	}
 }
 
-func flattenHTTPClientAuthenticationWithPreemptive(auth *repository.HTTPClientAuthenticationWithPreemptive, d *schema.ResourceData) []map[string]interface{} {
+func flattenHTTPClientAuthenticationWithPreemptive(auth *nexus3.HTTPClientAuthenticationWithPreemptive, d *schema.ResourceData) []map[string]interface{} {
 	if auth == nil {
 		return nil
 	}
 	return []map[string]interface{}{
 		{
-			"ntlm_domain": auth.NTLMDomain,
-			"ntlm_host":   auth.NTLMHost,
-			"type":        auth.Type,
-			"username":    auth.Username,
-			"password":    d.Get("http_client.0.authentication.0.password").(string),
-			"preemptive":  auth.Preemptive,
+			"ntlm_domain":  auth.NTLMDomain,
+			"ntlm_host":    auth.NTLMHost,
+			"type":         auth.Type,
+			"username":     auth.Username,
+			"password":     d.Get("http_client.0.authentication.0.password").(string),
+			"bearer_token": d.Get("http_client.0.authentication.0.bearer_token").(string),
+			"preemptive":   auth.Preemptive,
 		},
 	}
 }
@@ -183,6 +194,21 @@ func flattenProxy(proxy *repository.Proxy) []map[string]interface{} {
 	}
 }
 
+func flattenReplication(replication *nexus3.Replication) []map[string]interface{} {
+	// Disabled replication is reported as missing block, which is how it is
+	// disabled in the configuration
+	if replication == nil || (!replication.PreemptivePullEnabled && (replication.AssetPathRegex == nil || *replication.AssetPathRegex == "")) {
+		return nil
+	}
+	data := map[string]interface{}{
+		"preemptive_pull_enabled": replication.PreemptivePullEnabled,
+	}
+	if replication.AssetPathRegex != nil {
+		data["asset_path_regex"] = *replication.AssetPathRegex
+	}
+	return []map[string]interface{}{data}
+}
+
 func flattenStorage(storage *repository.Storage) []map[string]interface{} {
 	if storage == nil {
 		return nil
@@ -209,6 +235,23 @@ func flattenHostedStorage(storage *repository.HostedStorage) []map[string]interf
 	return []map[string]interface{}{data}
 }
 
+func flattenDockerHostedStorage(storage *nexus3.DockerHostedStorage) []map[string]interface{} {
+	if storage == nil {
+		return nil
+	}
+	data := map[string]interface{}{
+		"blob_store_name":                storage.BlobStoreName,
+		"strict_content_type_validation": storage.StrictContentTypeValidation,
+	}
+	if storage.WritePolicy != nil {
+		data["write_policy"] = storage.WritePolicy
+	}
+	if storage.LatestPolicy != nil {
+		data["latest_policy"] = *storage.LatestPolicy
+	}
+	return []map[string]interface{}{data}
+}
+
 func flattenMaven(maven *repository.Maven) []map[string]interface{} {
 	data := map[string]interface{}{
 		"version_policy": maven.VersionPolicy,
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateCleanupPolicies makes sure all cleanup policies referenced by the
+// repository exist, so the user gets a readable error at plan time instead of
+// a HTTP 400 from Nexus during apply.
+func validateCleanupPolicies(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.HasChange("cleanup") || !diff.NewValueKnown("cleanup") {
+		return nil
+	}
+
+	policyNames := []string{}
+	for _, cleanup := range diff.Get("cleanup").([]interface{}) {
+		if cleanup == nil {
+			continue
+		}
+		if v, ok := cleanup.(map[string]interface{})["policy_names"]; ok {
+			for _, name := range v.(*schema.Set).List() {
+				if name.(string) != "" {
+					policyNames = append(policyNames, name.(string))
+				}
+			}
+		}
+	}
+	if len(policyNames) == 0 {
+		return nil
+	}
+
+	return checkCleanupPolicies(nexus3.NewClient(m.(*nexus.NexusClient)), policyNames)
+}
+
+func checkCleanupPolicies(client *nexus3.NexusClient, policyNames []string) error {
+	policies, err := client.CleanupPolicy.List()
+	if nexus3.HasStatusCode(err, http.StatusForbidden, http.StatusNotFound) {
+		// Older Nexus versions have no cleanup policy API and reading them
+		// requires additional privileges, do not block the plan in these
+		// cases and let Nexus decide instead.
+		log.Printf("[WARN] Skipping cleanup policy validation: %v", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	missing := []string{}
+	for _, name := range policyNames {
+		found := false
+		for _, policy := range policies {
+			if strings.EqualFold(policy.Name, name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("cleanup policies do not exist: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
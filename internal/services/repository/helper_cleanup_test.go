@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCleanupPolicyClient(t *testing.T, statusCode int, body string) *nexus3.NexusClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/cleanup-policies", r.URL.Path)
+		w.WriteHeader(statusCode)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return nexus3.NewClient(nexus.NewClient(client.Config{URL: server.URL}))
+}
+
+func TestCheckCleanupPolicies(t *testing.T) {
+	policies := `[{"name": "weekly", "format": "maven2"}, {"name": "Daily", "format": "ALL_FORMATS"}]`
+
+	client := testCleanupPolicyClient(t, http.StatusOK, policies)
+	assert.NoError(t, checkCleanupPolicies(client, []string{"weekly", "daily"}))
+
+	client = testCleanupPolicyClient(t, http.StatusOK, policies)
+	assert.EqualError(t, checkCleanupPolicies(client, []string{"weekly", "monthly", "yearly"}), "cleanup policies do not exist: monthly, yearly")
+}
+
+func TestCheckCleanupPoliciesListError(t *testing.T) {
+	// Missing API or privileges skip the check
+	for _, statusCode := range []int{http.StatusForbidden, http.StatusNotFound} {
+		client := testCleanupPolicyClient(t, statusCode, "")
+		assert.NoError(t, checkCleanupPolicies(client, []string{"weekly"}), statusCode)
+	}
+
+	client := testCleanupPolicyClient(t, http.StatusInternalServerError, "boom")
+	assert.EqualError(t, checkCleanupPolicies(client, []string{"weekly"}), "could not list cleanup policies: HTTP: 500, boom")
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateForceDestroy refuses the deletion of a repository which still
+// contains components, unless force_destroy is set
+func validateForceDestroy(resourceData *schema.ResourceData, m interface{}) error {
+	if resourceData.Get("force_destroy").(bool) {
+		return nil
+	}
+
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	hasComponents, err := client.Component.HasComponents(resourceData.Id())
+	if err != nil {
+		return err
+	}
+	if hasComponents {
+		return fmt.Errorf("repository '%s' still contains components. Set force_destroy to true and apply before destroying it", resourceData.Id())
+	}
+	return nil
+}
+
+// importHostedRepositoryState sets the default of force_destroy, which is
+// not part of the API, to avoid a diff after import
+func importHostedRepositoryState(ctx context.Context, resourceData *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := resourceData.Set("force_destroy", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{resourceData}, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testComponentClient(t *testing.T, statusCode int, body string) *nexus.NexusClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/components", r.URL.Path)
+		assert.Equal(t, "raw-hosted", r.URL.Query().Get("repository"))
+		w.WriteHeader(statusCode)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return nexus.NewClient(client.Config{URL: server.URL})
+}
+
+func TestValidateForceDestroy(t *testing.T) {
+	resource := ResourceRepositoryRawHosted()
+	components := `{"items": [{"id": "1", "repository": "raw-hosted", "format": "raw", "name": "file.txt"}]}`
+
+	resourceData := resource.Data(&terraform.InstanceState{
+		ID:         "raw-hosted",
+		Attributes: map[string]string{"force_destroy": "false"},
+	})
+	assert.EqualError(t, validateForceDestroy(resourceData, testComponentClient(t, http.StatusOK, components)),
+		"repository 'raw-hosted' still contains components. Set force_destroy to true and apply before destroying it")
+	assert.NoError(t, validateForceDestroy(resourceData, testComponentClient(t, http.StatusOK, `{"items": []}`)))
+	assert.NoError(t, validateForceDestroy(resourceData, testComponentClient(t, http.StatusNotFound, "")))
+	assert.EqualError(t, validateForceDestroy(resourceData, testComponentClient(t, http.StatusInternalServerError, "boom")),
+		"could not list components of repository 'raw-hosted': HTTP: 500, boom")
+
+	// The components are not listed at all with force_destroy
+	resourceData = resource.Data(&terraform.InstanceState{
+		ID:         "raw-hosted",
+		Attributes: map[string]string{"force_destroy": "true"},
+	})
+	assert.NoError(t, validateForceDestroy(resourceData, nexus.NewClient(client.Config{URL: "http://127.0.0.1:1"})))
+}
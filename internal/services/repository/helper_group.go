@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withGroupStateUpgrade adds the state upgrade of group.member_names, which
+// was a set up to schema version 0, to a group repository resource.
+func withGroupStateUpgrade(resource *schema.Resource) *schema.Resource {
+	resource.SchemaVersion = 1
+	resource.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    resourceGroupV0(resource).CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceGroupStateUpgradeV0,
+			Version: 0,
+		},
+	}
+	return resource
+}
+
+// resourceGroupV0 returns the schema of a group repository resource with
+// member_names being a set.
+func resourceGroupV0(resource *schema.Resource) *schema.Resource {
+	group := *resource.Schema["group"]
+	groupElem := *group.Elem.(*schema.Resource)
+	groupElem.Schema = map[string]*schema.Schema{}
+	for k, v := range group.Elem.(*schema.Resource).Schema {
+		groupElem.Schema[k] = v
+	}
+	memberNames := *groupElem.Schema["member_names"]
+	memberNames.Type = schema.TypeSet
+	groupElem.Schema["member_names"] = &memberNames
+	group.Elem = &groupElem
+
+	resourceSchema := map[string]*schema.Schema{}
+	for k, v := range resource.Schema {
+		resourceSchema[k] = v
+	}
+	resourceSchema["group"] = &group
+	return &schema.Resource{
+		Schema: resourceSchema,
+	}
+}
+
+// resourceGroupStateUpgradeV0 keeps the state as it is. Sets and lists are
+// both stored as arrays, the next refresh reads the members in the order
+// returned by Nexus.
+func resourceGroupStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return rawState, nil
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceGroupStateUpgradeV0(t *testing.T) {
+	resource := ResourceRepositoryMavenGroup()
+	assert.Equal(t, 1, resource.SchemaVersion)
+	assert.Equal(t, schema.TypeList, resource.Schema["group"].Elem.(*schema.Resource).Schema["member_names"].Type)
+
+	resourceV0 := resourceGroupV0(resource)
+	assert.Equal(t, schema.TypeSet, resourceV0.Schema["group"].Elem.(*schema.Resource).Schema["member_names"].Type)
+	assert.True(t, resource.StateUpgraders[0].Type.Equals(resourceV0.CoreConfigSchema().ImpliedType()))
+
+	rawState := map[string]interface{}{
+		"name": "maven-public",
+		"group": []interface{}{
+			map[string]interface{}{
+				"member_names": []interface{}{"maven-releases", "maven-central"},
+			},
+		},
+	}
+	state, err := resourceGroupStateUpgradeV0(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, rawState, state)
+}
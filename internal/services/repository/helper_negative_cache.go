@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+)
+
+func getNegativeCache(negativeCacheList []interface{}) repository.NegativeCache {
+	if len(negativeCacheList) == 1 && negativeCacheList[0] != nil {
+		negativeCacheConfig := negativeCacheList[0].(map[string]interface{})
+		return repository.NegativeCache{
+			Enabled: negativeCacheConfig["enabled"].(bool),
+			TTL:     negativeCacheConfig["ttl"].(int),
+		}
+	}
+	// Same as the schema defaults of an empty negative_cache block
+	return repository.NegativeCache{
+		Enabled: false,
+		TTL:     1440,
+	}
+}
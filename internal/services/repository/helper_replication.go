@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func getReplication(resourceData *schema.ResourceData) *nexus3.Replication {
+	replicationList := resourceData.Get("replication").([]interface{})
+	if len(replicationList) == 1 && replicationList[0] != nil {
+		replicationConfig := replicationList[0].(map[string]interface{})
+		replication := nexus3.Replication{
+			PreemptivePullEnabled: replicationConfig["preemptive_pull_enabled"].(bool),
+		}
+		if assetPathRegex := replicationConfig["asset_path_regex"].(string); assetPathRegex != "" {
+			replication.AssetPathRegex = tools.GetStringPointer(assetPathRegex)
+		}
+		return &replication
+	}
+	// Nexus keeps the replication settings if they are not sent, so
+	// replication is disabled explicitly when the block is removed. Nothing
+	// is sent otherwise, Nexus OSS does not know replication at all.
+	if resourceData.HasChange("replication") {
+		return &nexus3.Replication{
+			PreemptivePullEnabled: false,
+		}
+	}
+	return nil
+}
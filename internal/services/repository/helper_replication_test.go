@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReplication(t *testing.T) {
+	resource := ResourceRepositoryRawProxy()
+
+	// Nothing is sent if replication was never configured
+	resourceData := resource.Data(&terraform.InstanceState{ID: "raw-proxy"})
+	assert.Nil(t, getReplication(resourceData))
+
+	resourceData.Set("replication", []interface{}{
+		map[string]interface{}{
+			"asset_path_regex":        "^/releases/.*",
+			"preemptive_pull_enabled": true,
+		},
+	})
+	assert.Equal(t, &nexus3.Replication{
+		AssetPathRegex:        tools.GetStringPointer("^/releases/.*"),
+		PreemptivePullEnabled: true,
+	}, getReplication(resourceData))
+
+	// Removing the block disables replication
+	resourceData, err := schema.InternalMap(resource.Schema).Data(
+		&terraform.InstanceState{
+			ID: "raw-proxy",
+			Attributes: map[string]string{
+				"replication.#":                         "1",
+				"replication.0.asset_path_regex":        "^/releases/.*",
+				"replication.0.preemptive_pull_enabled": "true",
+			},
+		},
+		&terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{
+				"replication.#":                         {Old: "1", New: "0"},
+				"replication.0.asset_path_regex":        {Old: "^/releases/.*", NewRemoved: true},
+				"replication.0.preemptive_pull_enabled": {Old: "true", NewRemoved: true},
+			},
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, &nexus3.Replication{PreemptivePullEnabled: false}, getReplication(resourceData))
+}
+
+func TestFlattenReplication(t *testing.T) {
+	assert.Nil(t, flattenReplication(nil))
+	assert.Nil(t, flattenReplication(&nexus3.Replication{PreemptivePullEnabled: false}))
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"asset_path_regex":        "^/releases/.*",
+			"preemptive_pull_enabled": false,
+		},
+	}, flattenReplication(&nexus3.Replication{AssetPathRegex: tools.GetStringPointer("^/releases/.*")}))
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateBlobStore makes sure the blob store referenced by the repository
+// exists or is created in the same run, so the user gets a readable error at
+// plan time instead of a HTTP 400 from Nexus during apply.
+func validateBlobStore(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.HasChange("storage") || !diff.NewValueKnown("storage.0.blob_store_name") {
+		return nil
+	}
+
+	name := diff.Get("storage.0.blob_store_name").(string)
+	if name == "" {
+		return nil
+	}
+
+	if tools.IsPlanned(m, tools.PlannedBlobStore, name) {
+		return nil
+	}
+
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	blobStores, err := client.BlobStore.List()
+	if nexus3.HasStatusCode(err, http.StatusForbidden) {
+		// Listing blob stores requires additional privileges, do not block
+		// the plan if they are missing and let Nexus decide instead.
+		log.Printf("[WARN] Skipping blob store validation: %v", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, blobStore := range blobStores {
+		if strings.EqualFold(blobStore.Name, name) {
+			return nil
+		}
+	}
+	return fmt.Errorf("blob store '%s' does not exist. A blob store created in the same run has to be referenced by its resource, e.g. nexus_blobstore_file.example.name", name)
+}
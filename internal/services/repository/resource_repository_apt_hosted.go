@@ -3,9 +3,12 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,13 +16,14 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted apt repository.",
 
-		Create: resourceAptHostedRepositoryCreate,
-		Delete: resourceAptHostedRepositoryDelete,
-		Exists: resourceAptHostedRepositoryExists,
-		Read:   resourceAptHostedRepositoryRead,
-		Update: resourceAptHostedRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceAptHostedRepositoryCreate,
+		Delete:        resourceAptHostedRepositoryDelete,
+		Exists:        resourceAptHostedRepositoryExists,
+		Read:          resourceAptHostedRepositoryRead,
+		Update:        resourceAptHostedRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHostedRepositoryState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,10 +31,12 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Hosted schemas
-			"cleanup":   repositorySchema.ResourceCleanup,
-			"component": repositorySchema.ResourceComponent,
-			"storage":   repositorySchema.ResourceHostedStorage,
+			"cleanup":       repositorySchema.ResourceCleanup,
+			"component":     repositorySchema.ResourceComponent,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
+			"storage":       repositorySchema.ResourceHostedStorage,
 			// Apt hosted schemas
 			"distribution": {
 				Description: "Distribution to fetch",
@@ -38,7 +44,7 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"signing": {
-				Description: "Signing contains signing data of hosted repositores of format Apt",
+				Description: "Signing contains signing data of hosted repositories of format Apt. The signing data is not returned by the Nexus API, so only a SHA256 hash of the keypair and passphrase is stored in the state",
 				Type:        schema.TypeList,
 				Required:    true,
 				MaxItems:    1,
@@ -49,12 +55,14 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 							Type:        schema.TypeString,
 							Required:    true,
 							Sensitive:   true,
+							StateFunc:   tools.HashSensitiveValue,
 						},
 						"passphrase": {
 							Description: "Passphrase to access PGP signing key",
 							Type:        schema.TypeString,
 							Optional:    true,
 							Sensitive:   true,
+							StateFunc:   tools.HashSensitiveValue,
 						},
 					},
 				},
@@ -66,7 +74,6 @@ func ResourceRepositoryAptHosted() *schema.Resource {
 func getAptHostedRepositoryFromResourceData(resourceData *schema.ResourceData) repository.AptHostedRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	writePolicy := repository.StorageWritePolicy(storageConfig["write_policy"].(string))
-	signingConfig := resourceData.Get("signing").([]interface{})[0].(map[string]interface{})
 
 	repo := repository.AptHostedRepository{
 		Name:   resourceData.Get("name").(string),
@@ -79,13 +86,7 @@ func getAptHostedRepositoryFromResourceData(resourceData *schema.ResourceData) r
 		Apt: repository.AptHosted{
 			Distribution: resourceData.Get("distribution").(string),
 		},
-		AptSigning: repository.AptSigning{
-			Keypair: signingConfig["keypair"].(string),
-		},
-	}
-
-	if signingConfig["passphrase"] != nil {
-		repo.AptSigning.Passphrase = tools.GetStringPointer(signingConfig["passphrase"].(string))
+		AptSigning: getAptSigningFromRawConfig(resourceData),
 	}
 
 	cleanupList := resourceData.Get("cleanup").([]interface{})
@@ -114,6 +115,27 @@ func getAptHostedRepositoryFromResourceData(resourceData *schema.ResourceData) r
 	return repo
 }
 
+// getAptSigningFromRawConfig reads the signing data from the configuration,
+// because the state only contains hashes of the keypair and passphrase.
+func getAptSigningFromRawConfig(resourceData *schema.ResourceData) repository.AptSigning {
+	signing := repository.AptSigning{}
+
+	signingList := resourceData.GetRawConfig().GetAttr("signing")
+	if signingList.IsNull() || !signingList.IsKnown() || signingList.LengthInt() == 0 {
+		return signing
+	}
+	signingConfig := signingList.Index(cty.NumberIntVal(0))
+
+	if keypair := signingConfig.GetAttr("keypair"); !keypair.IsNull() && keypair.IsKnown() {
+		signing.Keypair = keypair.AsString()
+	}
+	if passphrase := signingConfig.GetAttr("passphrase"); !passphrase.IsNull() && passphrase.IsKnown() {
+		signing.Passphrase = tools.GetStringPointer(passphrase.AsString())
+	}
+
+	return signing
+}
+
 func setAptHostedRepositoryToResourceData(repo *repository.AptHostedRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
@@ -153,19 +175,19 @@ func resourceAptHostedRepositoryCreate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceAptHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Apt.Hosted.Get(resourceData.Id())
+	var repo repository.AptHostedRepository
+	url, err := client.Repository.GetWithURL("apt", "hosted", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setAptHostedRepositoryToResourceData(repo, resourceData)
+	return setAptHostedRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceAptHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
@@ -182,6 +204,10 @@ func resourceAptHostedRepositoryUpdate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceAptHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	if err := validateForceDestroy(resourceData, m); err != nil {
+		return err
+	}
+
 	client := m.(*nexus.NexusClient)
 	return client.Repository.Apt.Hosted.Delete(resourceData.Id())
 }
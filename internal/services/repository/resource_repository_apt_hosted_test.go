@@ -7,9 +7,9 @@ import (
 	"testing"
 	"text/template"
 
-	"github.com/datadrivers/go-nexus-client/nexus3/pkg/tools"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -79,8 +79,8 @@ func TestAccResourceRepositoryAptHosted(t *testing.T) {
 					),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "distribution", repo.Apt.Distribution),
-						resource.TestCheckResourceAttr(resourceName, "signing.0.keypair", repo.AptSigning.Keypair),
-						resource.TestCheckResourceAttr(resourceName, "signing.0.passphrase", string(*repo.AptSigning.Passphrase)),
+						resource.TestCheckResourceAttr(resourceName, "signing.0.keypair", tools.HashSensitiveValue(repo.AptSigning.Keypair)),
+						resource.TestCheckResourceAttr(resourceName, "signing.0.passphrase", tools.HashSensitiveValue(*repo.AptSigning.Passphrase)),
 					),
 				),
 			},
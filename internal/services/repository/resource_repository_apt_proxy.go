@@ -3,21 +3,24 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryAptProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create a hosted apt repository.",
-
-		Create: resourceAptProxyRepositoryCreate,
-		Delete: resourceAptProxyRepositoryDelete,
-		Exists: resourceAptProxyRepositoryExists,
-		Read:   resourceAptProxyRepositoryRead,
-		Update: resourceAptProxyRepositoryUpdate,
+		Description: "Use this resource to create an apt proxy repository.",
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceAptProxyRepositoryCreate,
+		Delete:        resourceAptProxyRepositoryDelete,
+		Exists:        resourceAptProxyRepositoryExists,
+		Read:          resourceAptProxyRepositoryRead,
+		Update:        resourceAptProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,11 +30,13 @@ func ResourceRepositoryAptProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
 			// Apt proxy schemas
@@ -41,7 +46,7 @@ func ResourceRepositoryAptProxy() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"flat": {
-				Description: "Distribution to fetch",
+				Description: "Whether this repository is flat",
 				Required:    true,
 				Type:        schema.TypeBool,
 			},
@@ -49,13 +54,12 @@ func ResourceRepositoryAptProxy() *schema.Resource {
 	}
 }
 
-func getAptProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.AptProxyRepository {
+func getAptProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.AptProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.AptProxyRepository{
+	repo := nexus3.AptProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
@@ -66,14 +70,11 @@ func getAptProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 			Distribution: resourceData.Get("distribution").(string),
 			Flat:         resourceData.Get("flat").(bool),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -104,12 +105,13 @@ func getAptProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
 			}
 		}
 	}
@@ -118,10 +120,12 @@ func getAptProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setAptProxyRepositoryToResourceData(repo *repository.AptProxyRepository, resourceData *schema.ResourceData) error {
+func setAptProxyRepositoryToResourceData(repo *nexus3.AptProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -132,6 +136,8 @@ func setAptProxyRepositoryToResourceData(repo *repository.AptProxyRepository, re
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
@@ -155,11 +161,15 @@ func setAptProxyRepositoryToResourceData(repo *repository.AptProxyRepository, re
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceAptProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getAptProxyRepositoryFromResourceData(resourceData)
 
@@ -172,7 +182,7 @@ func resourceAptProxyRepositoryCreate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceAptProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Apt.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -184,11 +194,15 @@ func resourceAptProxyRepositoryRead(resourceData *schema.ResourceData, m interfa
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setAptProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceAptProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getAptProxyRepositoryFromResourceData(resourceData)
@@ -201,12 +215,12 @@ func resourceAptProxyRepositoryUpdate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceAptProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Apt.Proxy.Delete(resourceData.Id())
 }
 
 func resourceAptProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Apt.Proxy.Get(resourceData.Id())
 	return repo != nil, err
@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryAptProxy() repository.AptProxyRepository {
+func testAccResourceRepositoryAptProxy() nexus3.AptProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.AptProxyRepository{
+	return nexus3.AptProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Apt: repository.AptProxy{
@@ -35,10 +36,10 @@ func testAccResourceRepositoryAptProxy() repository.AptProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -64,7 +65,7 @@ func testAccResourceRepositoryAptProxy() repository.AptProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryAptProxyConfig(repo repository.AptProxyRepository) string {
+func testAccResourceRepositoryAptProxyConfig(repo nexus3.AptProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryAptProxyTemplate := template.Must(template.New("AptProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryAptProxy))
 	if err := resourceRepositoryAptProxyTemplate.Execute(buf, repo); err != nil {
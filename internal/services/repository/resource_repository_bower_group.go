@@ -3,20 +3,22 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryBowerGroup() *schema.Resource {
-	return &schema.Resource{
+	return withGroupStateUpgrade(&schema.Resource{
 		Description: "Use this resource to create a group bower repository.",
 
-		Create: resourceBowerGroupRepositoryCreate,
-		Delete: resourceBowerGroupRepositoryDelete,
-		Exists: resourceBowerGroupRepositoryExists,
-		Read:   resourceBowerGroupRepositoryRead,
-		Update: resourceBowerGroupRepositoryUpdate,
+		CustomizeDiff: validateBlobStore,
+		Create:        resourceBowerGroupRepositoryCreate,
+		Delete:        resourceBowerGroupRepositoryDelete,
+		Exists:        resourceBowerGroupRepositoryExists,
+		Read:          resourceBowerGroupRepositoryRead,
+		Update:        resourceBowerGroupRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,18 +28,19 @@ func ResourceRepositoryBowerGroup() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
 		},
-	}
+	})
 }
 
 func getBowerGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.BowerGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
@@ -86,19 +89,19 @@ func resourceBowerGroupRepositoryCreate(resourceData *schema.ResourceData, m int
 }
 
 func resourceBowerGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Bower.Group.Get(resourceData.Id())
+	var repo repository.BowerGroupRepository
+	url, err := client.Repository.GetWithURL("bower", "group", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setBowerGroupRepositoryToResourceData(repo, resourceData)
+	return setBowerGroupRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceBowerGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
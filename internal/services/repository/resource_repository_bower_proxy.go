@@ -3,21 +3,24 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryBowerProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create an bower proxy repository.",
-
-		Create: resourceBowerProxyRepositoryCreate,
-		Delete: resourceBowerProxyRepositoryDelete,
-		Exists: resourceBowerProxyRepositoryExists,
-		Read:   resourceBowerProxyRepositoryRead,
-		Update: resourceBowerProxyRepositoryUpdate,
+		Description: "Use this resource to create a bower proxy repository.",
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceBowerProxyRepositoryCreate,
+		Delete:        resourceBowerProxyRepositoryDelete,
+		Exists:        resourceBowerProxyRepositoryExists,
+		Read:          resourceBowerProxyRepositoryRead,
+		Update:        resourceBowerProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,11 +30,13 @@ func ResourceRepositoryBowerProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
 			// Bower proxy schemas
@@ -44,27 +49,23 @@ func ResourceRepositoryBowerProxy() *schema.Resource {
 	}
 }
 
-func getBowerProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.BowerProxyRepository {
+func getBowerProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.BowerProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.BowerProxyRepository{
+	repo := nexus3.BowerProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -98,12 +99,13 @@ func getBowerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
 			}
 		}
 	}
@@ -112,10 +114,12 @@ func getBowerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setBowerProxyRepositoryToResourceData(repo *repository.BowerProxyRepository, resourceData *schema.ResourceData) error {
+func setBowerProxyRepositoryToResourceData(repo *nexus3.BowerProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -124,6 +128,8 @@ func setBowerProxyRepositoryToResourceData(repo *repository.BowerProxyRepository
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
@@ -149,11 +155,15 @@ func setBowerProxyRepositoryToResourceData(repo *repository.BowerProxyRepository
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceBowerProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getBowerProxyRepositoryFromResourceData(resourceData)
 
@@ -166,7 +176,7 @@ func resourceBowerProxyRepositoryCreate(resourceData *schema.ResourceData, m int
 }
 
 func resourceBowerProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Bower.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -178,11 +188,15 @@ func resourceBowerProxyRepositoryRead(resourceData *schema.ResourceData, m inter
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setBowerProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceBowerProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getBowerProxyRepositoryFromResourceData(resourceData)
@@ -195,12 +209,12 @@ func resourceBowerProxyRepositoryUpdate(resourceData *schema.ResourceData, m int
 }
 
 func resourceBowerProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Bower.Proxy.Delete(resourceData.Id())
 }
 
 func resourceBowerProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Bower.Proxy.Get(resourceData.Id())
 	return repo != nil, err
@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryBowerProxy() repository.BowerProxyRepository {
+func testAccResourceRepositoryBowerProxy() nexus3.BowerProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.BowerProxyRepository{
+	return nexus3.BowerProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryBowerProxy() repository.BowerProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -63,7 +64,7 @@ func testAccResourceRepositoryBowerProxy() repository.BowerProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryBowerProxyConfig(repo repository.BowerProxyRepository) string {
+func testAccResourceRepositoryBowerProxyConfig(repo nexus3.BowerProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryBowerProxyTemplate := template.Must(template.New("BowerProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryBowerProxy))
 	if err := resourceRepositoryBowerProxyTemplate.Execute(buf, repo); err != nil {
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryCacheInvalidation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to invalidate the cache of a proxy or group repository.
+
+The cache is invalidated on creation and whenever ` + "`triggers`" + ` change. Destroying the resource has no effect on the repository.`,
+
+		Create: resourceRepositoryCacheInvalidationCreate,
+		Read:   resourceRepositoryCacheInvalidationRead,
+		Delete: resourceRepositoryCacheInvalidationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "The name of the proxy or group repository",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values which invalidate the cache again when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ForceNew: true,
+				Optional: true,
+				Type:     schema.TypeMap,
+			},
+		},
+	}
+}
+
+func resourceRepositoryCacheInvalidationCreate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	name := d.Get("repository").(string)
+
+	if err := client.Repository.InvalidateCache(name); err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return resourceRepositoryCacheInvalidationRead(d, m)
+}
+
+func resourceRepositoryCacheInvalidationRead(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	info, err := client.Repository.Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if info == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository", info.Name)
+
+	return nil
+}
+
+func resourceRepositoryCacheInvalidationDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryCacheInvalidationConfig(trigger string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_cache_invalidation" "acceptance" {
+	repository = nexus_repository_raw_proxy.acceptance.name
+	triggers = {
+		run = "%s"
+	}
+}
+`, trigger)
+}
+
+func TestAccResourceRepositoryCacheInvalidation(t *testing.T) {
+	repo := testAccResourceRepositoryRawProxy()
+	resourceName := "nexus_repository_cache_invalidation.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryRawProxyConfig(repo) + testAccResourceRepositoryCacheInvalidationConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "repository", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "first"),
+				),
+			},
+			{
+				Config: testAccResourceRepositoryRawProxyConfig(repo) + testAccResourceRepositoryCacheInvalidationConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "second"),
+				),
+			},
+		},
+	})
+}
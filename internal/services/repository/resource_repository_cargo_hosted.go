@@ -0,0 +1,167 @@
+package repository
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryCargoHosted() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a hosted cargo repository.",
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceCargoHostedRepositoryCreate,
+		Delete:        resourceCargoHostedRepositoryDelete,
+		Exists:        resourceCargoHostedRepositoryExists,
+		Read:          resourceCargoHostedRepositoryRead,
+		Update:        resourceCargoHostedRepositoryUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importHostedRepositoryState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.ResourceID,
+			"name":   repositorySchema.ResourceName,
+			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
+			// Hosted schemas
+			"cleanup":       repositorySchema.ResourceCleanup,
+			"component":     repositorySchema.ResourceComponent,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
+			"storage":       repositorySchema.ResourceHostedStorage,
+		},
+	}
+}
+
+func getCargoHostedRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.CargoHostedRepository {
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+	writePolicy := repository.StorageWritePolicy(storageConfig["write_policy"].(string))
+
+	repo := nexus3.CargoHostedRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.HostedStorage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+			WritePolicy:                 &writePolicy,
+		},
+	}
+
+	cleanupList := resourceData.Get("cleanup").([]interface{})
+	if len(cleanupList) > 0 && cleanupList[0] != nil {
+		cleanupConfig := cleanupList[0].(map[string]interface{})
+		if len(cleanupConfig) > 0 {
+			policy_names, ok := cleanupConfig["policy_names"]
+			if ok {
+				repo.Cleanup = &repository.Cleanup{
+					PolicyNames: tools.InterfaceSliceToStringSlice(policy_names.(*schema.Set).List()),
+				}
+			}
+		}
+	}
+
+	componentList := resourceData.Get("component").([]interface{})
+	if len(componentList) > 0 && componentList[0] != nil {
+		componentConfig := componentList[0].(map[string]interface{})
+		if len(componentConfig) > 0 {
+			repo.Component = &repository.Component{
+				ProprietaryComponents: componentConfig["proprietary_components"].(bool),
+			}
+		}
+	}
+
+	return repo
+}
+
+func setCargoHostedRepositoryToResourceData(repo *nexus3.CargoHostedRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if err := resourceData.Set("storage", flattenHostedStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+			return err
+		}
+	}
+
+	if repo.Component != nil {
+		if err := resourceData.Set("component", flattenComponent(repo.Component)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceCargoHostedRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo := getCargoHostedRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Cargo.Hosted.Create(repo); err != nil {
+		return err
+	}
+	resourceData.SetId(repo.Name)
+
+	return resourceCargoHostedRepositoryRead(resourceData, m)
+}
+
+func resourceCargoHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo, err := client.Repository.Cargo.Hosted.Get(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
+	return setCargoHostedRepositoryToResourceData(repo, resourceData)
+}
+
+func resourceCargoHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repoName := resourceData.Id()
+	repo := getCargoHostedRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Cargo.Hosted.Update(repoName, repo); err != nil {
+		return err
+	}
+
+	return resourceCargoHostedRepositoryRead(resourceData, m)
+}
+
+func resourceCargoHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	if err := validateForceDestroy(resourceData, m); err != nil {
+		return err
+	}
+
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	return client.Repository.Cargo.Hosted.Delete(resourceData.Id())
+}
+
+func resourceCargoHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo, err := client.Repository.Cargo.Hosted.Get(resourceData.Id())
+	return repo != nil, err
+}
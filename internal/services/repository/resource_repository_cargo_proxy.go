@@ -0,0 +1,210 @@
+package repository
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryCargoProxy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a cargo proxy repository.",
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceCargoProxyRepositoryCreate,
+		Delete:        resourceCargoProxyRepositoryDelete,
+		Exists:        resourceCargoProxyRepositoryExists,
+		Read:          resourceCargoProxyRepositoryRead,
+		Update:        resourceCargoProxyRepositoryUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.ResourceID,
+			"name":   repositorySchema.ResourceName,
+			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
+			// Proxy schemas
+			"cleanup":        repositorySchema.ResourceCleanup,
+			"http_client":    repositorySchema.ResourceHTTPClient,
+			"negative_cache": repositorySchema.ResourceNegativeCache,
+			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
+			"routing_rule":   repositorySchema.ResourceRoutingRule,
+			"storage":        repositorySchema.ResourceStorage,
+		},
+	}
+}
+
+func getCargoProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.CargoProxyRepository {
+	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
+	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+
+	repo := nexus3.CargoProxyRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.Storage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+		},
+		HTTPClient: nexus3.HTTPClient{
+			AutoBlock: httpClientConfig["auto_block"].(bool),
+			Blocked:   httpClientConfig["blocked"].(bool),
+		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
+		Proxy: repository.Proxy{
+			ContentMaxAge:  proxyConfig["content_max_age"].(int),
+			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
+			RemoteURL:      proxyConfig["remote_url"].(string),
+		},
+	}
+
+	if routingRule, ok := resourceData.GetOk("routing_rule"); ok {
+		repo.RoutingRule = tools.GetStringPointer(routingRule.(string))
+		repo.RoutingRuleName = tools.GetStringPointer(routingRule.(string))
+	}
+
+	cleanupList := resourceData.Get("cleanup").([]interface{})
+	if len(cleanupList) > 0 && cleanupList[0] != nil {
+		cleanupConfig := cleanupList[0].(map[string]interface{})
+		if len(cleanupConfig) > 0 {
+			policy_names, ok := cleanupConfig["policy_names"]
+			if ok {
+				repo.Cleanup = &repository.Cleanup{
+					PolicyNames: tools.InterfaceSliceToStringSlice(policy_names.(*schema.Set).List()),
+				}
+			}
+		}
+	}
+
+	if v, ok := httpClientConfig["authentication"]; ok {
+		authList := v.([]interface{})
+		if len(authList) == 1 && authList[0] != nil {
+			authConfig := authList[0].(map[string]interface{})
+
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
+			}
+		}
+	}
+
+	if v, ok := httpClientConfig["connection"]; ok {
+		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
+	}
+
+	repo.Replication = getReplication(resourceData)
+
+	return repo
+}
+
+func setCargoProxyRepositoryToResourceData(repo *nexus3.CargoProxyRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if repo.RoutingRuleName != nil {
+		resourceData.Set("routing_rule", repo.RoutingRuleName)
+	} else if repo.RoutingRule != nil {
+		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
+	}
+
+	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("http_client", flattenHTTPClient(&repo.HTTPClient, resourceData)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("negative_cache", flattenNegativeCache(&repo.NegativeCache)); err != nil {
+		return err
+	}
+
+	if err := resourceData.Set("proxy", flattenProxy(&repo.Proxy)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+			return err
+		}
+	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceCargoProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo := getCargoProxyRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Cargo.Proxy.Create(repo); err != nil {
+		return err
+	}
+	resourceData.SetId(repo.Name)
+
+	return resourceCargoProxyRepositoryRead(resourceData, m)
+}
+
+func resourceCargoProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo, err := client.Repository.Cargo.Proxy.Get(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
+	return setCargoProxyRepositoryToResourceData(repo, resourceData)
+}
+
+func resourceCargoProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repoName := resourceData.Id()
+	repo := getCargoProxyRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Cargo.Proxy.Update(repoName, repo); err != nil {
+		return err
+	}
+
+	return resourceCargoProxyRepositoryRead(resourceData, m)
+}
+
+func resourceCargoProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	return client.Repository.Cargo.Proxy.Delete(resourceData.Id())
+}
+
+func resourceCargoProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo, err := client.Repository.Cargo.Proxy.Get(resourceData.Id())
+	return repo != nil, err
+}
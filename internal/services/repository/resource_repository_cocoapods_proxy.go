@@ -3,21 +3,24 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryCocoapodsProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create an cocoapods proxy repository.",
-
-		Create: resourceCocoapodsProxyRepositoryCreate,
-		Delete: resourceCocoapodsProxyRepositoryDelete,
-		Exists: resourceCocoapodsProxyRepositoryExists,
-		Read:   resourceCocoapodsProxyRepositoryRead,
-		Update: resourceCocoapodsProxyRepositoryUpdate,
+		Description: "Use this resource to create a cocoapods proxy repository.",
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceCocoapodsProxyRepositoryCreate,
+		Delete:        resourceCocoapodsProxyRepositoryDelete,
+		Exists:        resourceCocoapodsProxyRepositoryExists,
+		Read:          resourceCocoapodsProxyRepositoryRead,
+		Update:        resourceCocoapodsProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,38 +30,36 @@ func ResourceRepositoryCocoapodsProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
 		},
 	}
 }
 
-func getCocoapodsProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.CocoapodsProxyRepository {
+func getCocoapodsProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.CocoapodsProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.CocoapodsProxyRepository{
+	repo := nexus3.CocoapodsProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -89,12 +90,13 @@ func getCocoapodsProxyRepositoryFromResourceData(resourceData *schema.ResourceDa
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
 			}
 		}
 	}
@@ -103,10 +105,12 @@ func getCocoapodsProxyRepositoryFromResourceData(resourceData *schema.ResourceDa
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setCocoapodsProxyRepositoryToResourceData(repo *repository.CocoapodsProxyRepository, resourceData *schema.ResourceData) error {
+func setCocoapodsProxyRepositoryToResourceData(repo *nexus3.CocoapodsProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -115,6 +119,8 @@ func setCocoapodsProxyRepositoryToResourceData(repo *repository.CocoapodsProxyRe
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
@@ -138,11 +144,15 @@ func setCocoapodsProxyRepositoryToResourceData(repo *repository.CocoapodsProxyRe
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceCocoapodsProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getCocoapodsProxyRepositoryFromResourceData(resourceData)
 
@@ -155,7 +165,7 @@ func resourceCocoapodsProxyRepositoryCreate(resourceData *schema.ResourceData, m
 }
 
 func resourceCocoapodsProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Cocoapods.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -167,11 +177,15 @@ func resourceCocoapodsProxyRepositoryRead(resourceData *schema.ResourceData, m i
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setCocoapodsProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceCocoapodsProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getCocoapodsProxyRepositoryFromResourceData(resourceData)
@@ -184,12 +198,12 @@ func resourceCocoapodsProxyRepositoryUpdate(resourceData *schema.ResourceData, m
 }
 
 func resourceCocoapodsProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Cocoapods.Proxy.Delete(resourceData.Id())
 }
 
 func resourceCocoapodsProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Cocoapods.Proxy.Get(resourceData.Id())
 	return repo != nil, err
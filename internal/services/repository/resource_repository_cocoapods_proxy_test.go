@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryCocoapodsProxy() repository.CocoapodsProxyRepository {
+func testAccResourceRepositoryCocoapodsProxy() nexus3.CocoapodsProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.CocoapodsProxyRepository{
+	return nexus3.CocoapodsProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryCocoapodsProxy() repository.CocoapodsProxyReposito
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -60,7 +61,7 @@ func testAccResourceRepositoryCocoapodsProxy() repository.CocoapodsProxyReposito
 	}
 }
 
-func testAccResourceRepositoryCocoapodsProxyConfig(repo repository.CocoapodsProxyRepository) string {
+func testAccResourceRepositoryCocoapodsProxyConfig(repo nexus3.CocoapodsProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryCocoapodsProxyTemplate := template.Must(template.New("CocoapodsProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryCocoapodsProxy))
 	if err := resourceRepositoryCocoapodsProxyTemplate.Execute(buf, repo); err != nil {
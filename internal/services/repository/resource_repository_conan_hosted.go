@@ -0,0 +1,169 @@
+package repository
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryConanHosted() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to create a hosted conan repository.
+
+The Conan protocol version can only be chosen for proxy repositories, see ` + "`protocol_version`" + ` of ` + "`nexus_repository_conan_proxy`" + `. The Nexus API of hosted conan repositories (` + "`/v1/repositories/conan/hosted`" + `) has no such setting. There is no conan group repository resource.`,
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceConanHostedRepositoryCreate,
+		Delete:        resourceConanHostedRepositoryDelete,
+		Exists:        resourceConanHostedRepositoryExists,
+		Read:          resourceConanHostedRepositoryRead,
+		Update:        resourceConanHostedRepositoryUpdate,
+		Importer: &schema.ResourceImporter{
+			StateContext: importHostedRepositoryState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Common schemas
+			"id":     common.ResourceID,
+			"name":   repositorySchema.ResourceName,
+			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
+			// Hosted schemas
+			"cleanup":       repositorySchema.ResourceCleanup,
+			"component":     repositorySchema.ResourceComponent,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
+			"storage":       repositorySchema.ResourceHostedStorage,
+		},
+	}
+}
+
+func getConanHostedRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.ConanHostedRepository {
+	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
+	writePolicy := repository.StorageWritePolicy(storageConfig["write_policy"].(string))
+
+	repo := nexus3.ConanHostedRepository{
+		Name:   resourceData.Get("name").(string),
+		Online: resourceData.Get("online").(bool),
+		Storage: repository.HostedStorage{
+			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
+			WritePolicy:                 &writePolicy,
+		},
+	}
+
+	cleanupList := resourceData.Get("cleanup").([]interface{})
+	if len(cleanupList) > 0 && cleanupList[0] != nil {
+		cleanupConfig := cleanupList[0].(map[string]interface{})
+		if len(cleanupConfig) > 0 {
+			policy_names, ok := cleanupConfig["policy_names"]
+			if ok {
+				repo.Cleanup = &repository.Cleanup{
+					PolicyNames: tools.InterfaceSliceToStringSlice(policy_names.(*schema.Set).List()),
+				}
+			}
+		}
+	}
+
+	componentList := resourceData.Get("component").([]interface{})
+	if len(componentList) > 0 && componentList[0] != nil {
+		componentConfig := componentList[0].(map[string]interface{})
+		if len(componentConfig) > 0 {
+			repo.Component = &repository.Component{
+				ProprietaryComponents: componentConfig["proprietary_components"].(bool),
+			}
+		}
+	}
+
+	return repo
+}
+
+func setConanHostedRepositoryToResourceData(repo *nexus3.ConanHostedRepository, resourceData *schema.ResourceData) error {
+	resourceData.SetId(repo.Name)
+	resourceData.Set("name", repo.Name)
+	resourceData.Set("online", repo.Online)
+
+	if err := resourceData.Set("storage", flattenHostedStorage(&repo.Storage)); err != nil {
+		return err
+	}
+
+	if repo.Cleanup != nil {
+		if err := resourceData.Set("cleanup", flattenCleanup(repo.Cleanup)); err != nil {
+			return err
+		}
+	}
+
+	if repo.Component != nil {
+		if err := resourceData.Set("component", flattenComponent(repo.Component)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceConanHostedRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo := getConanHostedRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Conan.Hosted.Create(repo); err != nil {
+		return err
+	}
+	resourceData.SetId(repo.Name)
+
+	return resourceConanHostedRepositoryRead(resourceData, m)
+}
+
+func resourceConanHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo, err := client.Repository.Conan.Hosted.Get(resourceData.Id())
+	if err != nil {
+		return err
+	}
+
+	if repo == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
+	return setConanHostedRepositoryToResourceData(repo, resourceData)
+}
+
+func resourceConanHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repoName := resourceData.Id()
+	repo := getConanHostedRepositoryFromResourceData(resourceData)
+
+	if err := client.Repository.Conan.Hosted.Update(repoName, repo); err != nil {
+		return err
+	}
+
+	return resourceConanHostedRepositoryRead(resourceData, m)
+}
+
+func resourceConanHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	if err := validateForceDestroy(resourceData, m); err != nil {
+		return err
+	}
+
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	return client.Repository.Conan.Hosted.Delete(resourceData.Id())
+}
+
+func resourceConanHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	repo, err := client.Repository.Conan.Hosted.Get(resourceData.Id())
+	return repo != nil, err
+}
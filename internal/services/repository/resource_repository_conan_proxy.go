@@ -3,21 +3,25 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceRepositoryConanProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create an conan proxy repository.",
-
-		Create: resourceConanProxyRepositoryCreate,
-		Delete: resourceConanProxyRepositoryDelete,
-		Exists: resourceConanProxyRepositoryExists,
-		Read:   resourceConanProxyRepositoryRead,
-		Update: resourceConanProxyRepositoryUpdate,
+		Description: "Use this resource to create a conan proxy repository.",
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceConanProxyRepositoryCreate,
+		Delete:        resourceConanProxyRepositoryDelete,
+		Exists:        resourceConanProxyRepositoryExists,
+		Read:          resourceConanProxyRepositoryRead,
+		Update:        resourceConanProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,38 +31,47 @@ func ResourceRepositoryConanProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
+			// Conan proxy schemas
+			"protocol_version": {
+				Description: "Conan protocol version served by the repository. Possible values: `V1` or `V2`. If not set, Nexus chooses the version of a new repository and the version of an existing repository is kept",
+				Optional:    true,
+				Computed:    true,
+				Type:        schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(nexus3.ConanVersionV1),
+					string(nexus3.ConanVersionV2),
+				}, false),
+			},
 		},
 	}
 }
 
-func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.ConanProxyRepository {
+func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.ConanProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.ConanProxyRepository{
+	repo := nexus3.ConanProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -66,6 +79,12 @@ func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		},
 	}
 
+	if protocolVersion, ok := resourceData.GetOk("protocol_version"); ok {
+		repo.ConanProxy = &nexus3.ConanProxy{
+			ConanVersion: nexus3.ConanVersion(protocolVersion.(string)),
+		}
+	}
+
 	if routingRule, ok := resourceData.GetOk("routing_rule"); ok {
 		repo.RoutingRule = tools.GetStringPointer(routingRule.(string))
 		repo.RoutingRuleName = tools.GetStringPointer(routingRule.(string))
@@ -89,12 +108,13 @@ func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
 			}
 		}
 	}
@@ -103,18 +123,28 @@ func getConanProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setConanProxyRepositoryToResourceData(repo *repository.ConanProxyRepository, resourceData *schema.ResourceData) error {
+func setConanProxyRepositoryToResourceData(repo *nexus3.ConanProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
 
+	if repo.ConanProxy != nil {
+		resourceData.Set("protocol_version", string(repo.ConanVersion))
+	} else {
+		resourceData.Set("protocol_version", nil)
+	}
+
 	if repo.RoutingRuleName != nil {
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
@@ -138,11 +168,15 @@ func setConanProxyRepositoryToResourceData(repo *repository.ConanProxyRepository
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceConanProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getConanProxyRepositoryFromResourceData(resourceData)
 
@@ -155,7 +189,7 @@ func resourceConanProxyRepositoryCreate(resourceData *schema.ResourceData, m int
 }
 
 func resourceConanProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Conan.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -167,11 +201,15 @@ func resourceConanProxyRepositoryRead(resourceData *schema.ResourceData, m inter
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setConanProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceConanProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getConanProxyRepositoryFromResourceData(resourceData)
@@ -184,12 +222,12 @@ func resourceConanProxyRepositoryUpdate(resourceData *schema.ResourceData, m int
 }
 
 func resourceConanProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Conan.Proxy.Delete(resourceData.Id())
 }
 
 func resourceConanProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Conan.Proxy.Get(resourceData.Id())
 	return repo != nil, err
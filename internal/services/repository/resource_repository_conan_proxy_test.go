@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryConanProxy() repository.ConanProxyRepository {
+func testAccResourceRepositoryConanProxy() nexus3.ConanProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.ConanProxyRepository{
+	return nexus3.ConanProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryConanProxy() repository.ConanProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -55,12 +56,15 @@ func testAccResourceRepositoryConanProxy() repository.ConanProxyRepository {
 		Proxy: repository.Proxy{
 			ContentMaxAge:  770,
 			MetadataMaxAge: 770,
-			RemoteURL:      "https://center.conan.io",
+			RemoteURL:      "https://center2.conan.io",
+		},
+		ConanProxy: &nexus3.ConanProxy{
+			ConanVersion: nexus3.ConanVersionV2,
 		},
 	}
 }
 
-func testAccResourceRepositoryConanProxyConfig(repo repository.ConanProxyRepository) string {
+func testAccResourceRepositoryConanProxyConfig(repo nexus3.ConanProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryConanProxyTemplate := template.Must(template.New("ConanProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryConanProxy))
 	if err := resourceRepositoryConanProxyTemplate.Execute(buf, repo); err != nil {
@@ -93,6 +97,7 @@ func TestAccResourceRepositoryConanProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+						resource.TestCheckResourceAttr(resourceName, "protocol_version", string(repo.ConanProxy.ConanVersion)),
 					),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "http_client.#", "1"),
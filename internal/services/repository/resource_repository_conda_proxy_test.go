@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryCondaProxy() repository.CondaProxyRepository {
+func testAccResourceRepositoryCondaProxy() nexus3.CondaProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.CondaProxyRepository{
+	return nexus3.CondaProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryCondaProxy() repository.CondaProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -60,7 +61,7 @@ func testAccResourceRepositoryCondaProxy() repository.CondaProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryCondaProxyConfig(repo repository.CondaProxyRepository) string {
+func testAccResourceRepositoryCondaProxyConfig(repo nexus3.CondaProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryCondaProxyTemplate := template.Must(template.New("CondaProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryCondaProxy))
 	if err := resourceRepositoryCondaProxyTemplate.Execute(buf, repo); err != nil {
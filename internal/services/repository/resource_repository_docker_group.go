@@ -3,6 +3,7 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
@@ -10,14 +11,15 @@ import (
 )
 
 func ResourceRepositoryDockerGroup() *schema.Resource {
-	return &schema.Resource{
+	return withGroupStateUpgrade(&schema.Resource{
 		Description: "Use this resource to create a group docker repository.",
 
-		Create: resourceDockerGroupRepositoryCreate,
-		Delete: resourceDockerGroupRepositoryDelete,
-		Exists: resourceDockerGroupRepositoryExists,
-		Read:   resourceDockerGroupRepositoryRead,
-		Update: resourceDockerGroupRepositoryUpdate,
+		CustomizeDiff: validateBlobStore,
+		Create:        resourceDockerGroupRepositoryCreate,
+		Delete:        resourceDockerGroupRepositoryDelete,
+		Exists:        resourceDockerGroupRepositoryExists,
+		Read:          resourceDockerGroupRepositoryRead,
+		Update:        resourceDockerGroupRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,25 +29,26 @@ func ResourceRepositoryDockerGroup() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroupDeploy,
 			"storage": repositorySchema.ResourceStorage,
 			// Docker group schemas
 			"docker": repositorySchema.ResourceDocker,
 		},
-	}
+	})
 }
 
-func getDockerGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.DockerGroupRepository {
+func getDockerGroupRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.DockerGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	dockerConfig := resourceData.Get("docker").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
-	repo := repository.DockerGroupRepository{
+	repo := nexus3.DockerGroupRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
@@ -55,7 +58,7 @@ func getDockerGroupRepositoryFromResourceData(resourceData *schema.ResourceData)
 		Group: repository.GroupDeploy{
 			MemberNames: groupMemberNames,
 		},
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
 			V1Enabled:      dockerConfig["v1_enabled"].(bool),
 		},
@@ -77,10 +80,16 @@ func getDockerGroupRepositoryFromResourceData(resourceData *schema.ResourceData)
 		}
 	}
 
+	if subdomain, ok := dockerConfig["subdomain"]; ok {
+		if subdomain.(string) != "" {
+			repo.Docker.Subdomain = tools.GetStringPointer(subdomain.(string))
+		}
+	}
+
 	return repo
 }
 
-func setDockerGroupRepositoryToResourceData(repo *repository.DockerGroupRepository, resourceData *schema.ResourceData) error {
+func setDockerGroupRepositoryToResourceData(repo *nexus3.DockerGroupRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -101,7 +110,7 @@ func setDockerGroupRepositoryToResourceData(repo *repository.DockerGroupReposito
 }
 
 func resourceDockerGroupRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getDockerGroupRepositoryFromResourceData(resourceData)
 
@@ -114,7 +123,7 @@ func resourceDockerGroupRepositoryCreate(resourceData *schema.ResourceData, m in
 }
 
 func resourceDockerGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Docker.Group.Get(resourceData.Id())
 	if err != nil {
@@ -126,11 +135,15 @@ func resourceDockerGroupRepositoryRead(resourceData *schema.ResourceData, m inte
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setDockerGroupRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceDockerGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getDockerGroupRepositoryFromResourceData(resourceData)
@@ -143,12 +156,12 @@ func resourceDockerGroupRepositoryUpdate(resourceData *schema.ResourceData, m in
 }
 
 func resourceDockerGroupRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Docker.Group.Delete(resourceData.Id())
 }
 
 func resourceDockerGroupRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Docker.Group.Get(resourceData.Id())
 	return repo != nil, err
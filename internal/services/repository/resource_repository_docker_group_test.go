@@ -10,16 +10,17 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryDockerGroup() repository.DockerGroupRepository {
-	return repository.DockerGroupRepository{
+func testAccResourceRepositoryDockerGroup() nexus3.DockerGroupRepository {
+	return nexus3.DockerGroupRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: true,
 			HTTPPort:       tools.GetIntPointer(rand.Intn(999) + 32000),
 			HTTPSPort:      tools.GetIntPointer(rand.Intn(999) + 33000),
@@ -35,7 +36,7 @@ func testAccResourceRepositoryDockerGroup() repository.DockerGroupRepository {
 	}
 }
 
-func testAccResourceRepositoryDockerGroupConfig(repo repository.DockerGroupRepository) string {
+func testAccResourceRepositoryDockerGroupConfig(repo nexus3.DockerGroupRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryDockerGroupTemplate := template.Must(template.New("DockerGroupRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryDockerGroup))
 	if err := resourceRepositoryDockerGroupTemplate.Execute(buf, repo); err != nil {
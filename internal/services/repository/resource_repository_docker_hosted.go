@@ -3,9 +3,11 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,13 +15,14 @@ func ResourceRepositoryDockerHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted docker repository.",
 
-		Create: resourceDockerHostedRepositoryCreate,
-		Delete: resourceDockerHostedRepositoryDelete,
-		Exists: resourceDockerHostedRepositoryExists,
-		Read:   resourceDockerHostedRepositoryRead,
-		Update: resourceDockerHostedRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceDockerHostedRepositoryCreate,
+		Delete:        resourceDockerHostedRepositoryDelete,
+		Exists:        resourceDockerHostedRepositoryExists,
+		Read:          resourceDockerHostedRepositoryRead,
+		Update:        resourceDockerHostedRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHostedRepositoryState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,30 +30,33 @@ func ResourceRepositoryDockerHosted() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Hosted schemas
-			"cleanup":   repositorySchema.ResourceCleanup,
-			"component": repositorySchema.ResourceComponent,
-			"storage":   repositorySchema.ResourceHostedStorage,
+			"cleanup":       repositorySchema.ResourceCleanup,
+			"component":     repositorySchema.ResourceComponent,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
+			"storage":       repositorySchema.ResourceDockerHostedStorage,
 			// Docker hosted schemas
 			"docker": repositorySchema.ResourceDocker,
 		},
 	}
 }
 
-func getDockerHostedRepositoryFromResourceData(resourceData *schema.ResourceData) repository.DockerHostedRepository {
+func getDockerHostedRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.DockerHostedRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	writePolicy := repository.StorageWritePolicy(storageConfig["write_policy"].(string))
 	dockerConfig := resourceData.Get("docker").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.DockerHostedRepository{
+	repo := nexus3.DockerHostedRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
-		Storage: repository.HostedStorage{
+		Storage: nexus3.DockerHostedStorage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
+			LatestPolicy:                tools.GetBoolPointer(storageConfig["latest_policy"].(bool)),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 			WritePolicy:                 &writePolicy,
 		},
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
 			V1Enabled:      dockerConfig["v1_enabled"].(bool),
 		},
@@ -68,6 +74,12 @@ func getDockerHostedRepositoryFromResourceData(resourceData *schema.ResourceData
 		}
 	}
 
+	if subdomain, ok := dockerConfig["subdomain"]; ok {
+		if subdomain.(string) != "" {
+			repo.Docker.Subdomain = tools.GetStringPointer(subdomain.(string))
+		}
+	}
+
 	cleanupList := resourceData.Get("cleanup").([]interface{})
 	if len(cleanupList) > 0 && cleanupList[0] != nil {
 		cleanupConfig := cleanupList[0].(map[string]interface{})
@@ -94,7 +106,7 @@ func getDockerHostedRepositoryFromResourceData(resourceData *schema.ResourceData
 	return repo
 }
 
-func setDockerHostedRepositoryToResourceData(repo *repository.DockerHostedRepository, resourceData *schema.ResourceData) error {
+func setDockerHostedRepositoryToResourceData(repo *nexus3.DockerHostedRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -103,7 +115,7 @@ func setDockerHostedRepositoryToResourceData(repo *repository.DockerHostedReposi
 		return err
 	}
 
-	if err := resourceData.Set("storage", flattenHostedStorage(&repo.Storage)); err != nil {
+	if err := resourceData.Set("storage", flattenDockerHostedStorage(&repo.Storage)); err != nil {
 		return err
 	}
 
@@ -123,7 +135,7 @@ func setDockerHostedRepositoryToResourceData(repo *repository.DockerHostedReposi
 }
 
 func resourceDockerHostedRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getDockerHostedRepositoryFromResourceData(resourceData)
 
@@ -136,7 +148,7 @@ func resourceDockerHostedRepositoryCreate(resourceData *schema.ResourceData, m i
 }
 
 func resourceDockerHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Docker.Hosted.Get(resourceData.Id())
 	if err != nil {
@@ -148,11 +160,15 @@ func resourceDockerHostedRepositoryRead(resourceData *schema.ResourceData, m int
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setDockerHostedRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceDockerHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getDockerHostedRepositoryFromResourceData(resourceData)
@@ -165,12 +181,16 @@ func resourceDockerHostedRepositoryUpdate(resourceData *schema.ResourceData, m i
 }
 
 func resourceDockerHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	if err := validateForceDestroy(resourceData, m); err != nil {
+		return err
+	}
+
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Docker.Hosted.Delete(resourceData.Id())
 }
 
 func resourceDockerHostedRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Docker.Hosted.Get(resourceData.Id())
 	return repo != nil, err
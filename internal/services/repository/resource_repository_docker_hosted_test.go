@@ -10,25 +10,27 @@ import (
 
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryDockerHosted() repository.DockerHostedRepository {
-	writePolicy := repository.StorageWritePolicyAllow
+func testAccResourceRepositoryDockerHosted() nexus3.DockerHostedRepository {
+	writePolicy := repository.StorageWritePolicyAllowOnce
 
-	return repository.DockerHostedRepository{
+	return nexus3.DockerHostedRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: true,
 			HTTPPort:       tools.GetIntPointer(rand.Intn(999) + 32000),
 			HTTPSPort:      tools.GetIntPointer(rand.Intn(999) + 33000),
 			V1Enabled:      false,
 		},
-		Storage: repository.HostedStorage{
+		Storage: nexus3.DockerHostedStorage{
 			BlobStoreName:               "default",
+			LatestPolicy:                tools.GetBoolPointer(true),
 			StrictContentTypeValidation: true,
 			WritePolicy:                 &writePolicy,
 		},
@@ -41,7 +43,7 @@ func testAccResourceRepositoryDockerHosted() repository.DockerHostedRepository {
 	}
 }
 
-func testAccResourceRepositoryDockerHostedConfig(repo repository.DockerHostedRepository) string {
+func testAccResourceRepositoryDockerHostedConfig(repo nexus3.DockerHostedRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryDockerHostedTemplate := template.Must(template.New("DockerHostedRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryDockerHosted))
 	if err := resourceRepositoryDockerHostedTemplate.Execute(buf, repo); err != nil {
@@ -54,6 +56,12 @@ func TestAccResourceRepositoryDockerHosted(t *testing.T) {
 	repo := testAccResourceRepositoryDockerHosted()
 	resourceName := "nexus_repository_docker_hosted.acceptance"
 
+	subdomain := ""
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "false" {
+		subdomain = repo.Name
+	}
+	repo.Docker.Subdomain = &subdomain
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acceptance.AccPreCheck(t) },
 		Providers: acceptance.TestAccProviders,
@@ -71,6 +79,7 @@ func TestAccResourceRepositoryDockerHosted(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "storage.0.blob_store_name", repo.Storage.BlobStoreName),
 						resource.TestCheckResourceAttr(resourceName, "storage.0.strict_content_type_validation", strconv.FormatBool(repo.Storage.StrictContentTypeValidation)),
 						resource.TestCheckResourceAttr(resourceName, "storage.0.write_policy", string(*repo.Storage.WritePolicy)),
+						resource.TestCheckResourceAttr(resourceName, "storage.0.latest_policy", strconv.FormatBool(*repo.Storage.LatestPolicy)),
 						resource.TestCheckResourceAttr(resourceName, "cleanup.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.#", "1"),
 						resource.TestCheckResourceAttr(resourceName, "cleanup.0.policy_names.0", repo.Cleanup.PolicyNames[0]),
@@ -82,6 +91,7 @@ func TestAccResourceRepositoryDockerHosted(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "docker.0.force_basic_auth", strconv.FormatBool(repo.Docker.ForceBasicAuth)),
 						resource.TestCheckResourceAttr(resourceName, "docker.0.http_port", strconv.Itoa(*repo.Docker.HTTPPort)),
 						resource.TestCheckResourceAttr(resourceName, "docker.0.https_port", strconv.Itoa(*repo.Docker.HTTPSPort)),
+						resource.TestCheckResourceAttr(resourceName, "docker.0.subdomain", subdomain),
 						resource.TestCheckResourceAttr(resourceName, "docker.0.v1_enabled", strconv.FormatBool(repo.Docker.V1Enabled)),
 					),
 				),
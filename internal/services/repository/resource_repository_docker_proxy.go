@@ -6,9 +6,11 @@ import (
 
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -17,11 +19,12 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a docker proxy repository.",
 
-		Create: resourceDockerProxyRepositoryCreate,
-		Delete: resourceDockerProxyRepositoryDelete,
-		Exists: resourceDockerProxyRepositoryExists,
-		Read:   resourceDockerProxyRepositoryRead,
-		Update: resourceDockerProxyRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceDockerProxyRepositoryCreate,
+		Delete:        resourceDockerProxyRepositoryDelete,
+		Exists:        resourceDockerProxyRepositoryExists,
+		Read:          resourceDockerProxyRepositoryRead,
+		Update:        resourceDockerProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,11 +34,13 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
 			// Docker proxy schemas
@@ -47,6 +52,19 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"cache_foreign_layers": {
+							Description: "Allow Nexus Repository Manager to download and cache foreign layers",
+							Optional:    true,
+							Type:        schema.TypeBool,
+						},
+						"foreign_layer_url_whitelist": {
+							Description: "A set of regular expressions used to identify URLs that are allowed for foreign layer requests",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+							Type:     schema.TypeSet,
+						},
 						"index_type": {
 							Description:  "Type of Docker Index. Possible values: `HUB`, `REGISTRY` or `CUSTOM`",
 							Required:     true,
@@ -66,36 +84,32 @@ func ResourceRepositoryDockerProxy() *schema.Resource {
 	}
 }
 
-func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.DockerProxyRepository {
+func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.DockerProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	dockerConfig := resourceData.Get("docker").([]interface{})[0].(map[string]interface{})
 	dockerProxyConfig := resourceData.Get("docker_proxy").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.DockerProxyRepository{
+	repo := nexus3.DockerProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: dockerConfig["force_basic_auth"].(bool),
 			V1Enabled:      dockerConfig["v1_enabled"].(bool),
 		},
-		DockerProxy: repository.DockerProxy{
+		DockerProxy: nexus3.DockerProxy{
 			IndexType: repository.DockerProxyIndexType(dockerProxyConfig["index_type"].(string)),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -115,6 +129,20 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		}
 	}
 
+	if subdomain, ok := dockerConfig["subdomain"]; ok {
+		if subdomain.(string) != "" {
+			repo.Docker.Subdomain = tools.GetStringPointer(subdomain.(string))
+		}
+	}
+
+	if cacheForeignLayers, ok := dockerProxyConfig["cache_foreign_layers"]; ok {
+		repo.DockerProxy.CacheForeignLayers = tools.GetBoolPointer(cacheForeignLayers.(bool))
+	}
+
+	if whitelist, ok := dockerProxyConfig["foreign_layer_url_whitelist"]; ok {
+		repo.DockerProxy.ForeignLayerURLWhitelist = tools.InterfaceSliceToStringSlice(whitelist.(*schema.Set).List())
+	}
+
 	if dockerProxyConfig["index_url"].(string) != "" {
 		repo.DockerProxy.IndexURL = tools.GetStringPointer(strings.TrimSpace(dockerProxyConfig["index_url"].(string)))
 	}
@@ -142,12 +170,13 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
 			}
 		}
 	}
@@ -156,10 +185,12 @@ func getDockerProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyRepository, resourceData *schema.ResourceData) error {
+func setDockerProxyRepositoryToResourceData(repo *nexus3.DockerProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -176,6 +207,8 @@ func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyReposito
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
@@ -199,11 +232,15 @@ func setDockerProxyRepositoryToResourceData(repo *repository.DockerProxyReposito
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceDockerProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getDockerProxyRepositoryFromResourceData(resourceData)
 
@@ -216,7 +253,7 @@ func resourceDockerProxyRepositoryCreate(resourceData *schema.ResourceData, m in
 }
 
 func resourceDockerProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Docker.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -228,11 +265,15 @@ func resourceDockerProxyRepositoryRead(resourceData *schema.ResourceData, m inte
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setDockerProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceDockerProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getDockerProxyRepositoryFromResourceData(resourceData)
@@ -245,12 +286,12 @@ func resourceDockerProxyRepositoryUpdate(resourceData *schema.ResourceData, m in
 }
 
 func resourceDockerProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Docker.Proxy.Delete(resourceData.Id())
 }
 
 func resourceDockerProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Docker.Proxy.Get(resourceData.Id())
 	return repo != nil, err
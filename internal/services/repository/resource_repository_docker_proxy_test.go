@@ -11,26 +11,29 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryDockerProxy() repository.DockerProxyRepository {
+func testAccResourceRepositoryDockerProxy() nexus3.DockerProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.DockerProxyRepository{
+	return nexus3.DockerProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
-		DockerProxy: repository.DockerProxy{
-			IndexType: repository.DockerProxyIndexTypeRegistry,
-			IndexURL:  tools.GetStringPointer("https://docker.elastic.co/index.json"),
+		DockerProxy: nexus3.DockerProxy{
+			CacheForeignLayers:       tools.GetBoolPointer(true),
+			ForeignLayerURLWhitelist: []string{".*"},
+			IndexType:                repository.DockerProxyIndexTypeRegistry,
+			IndexURL:                 tools.GetStringPointer("https://docker.elastic.co/index.json"),
 		},
-		Docker: repository.Docker{
+		Docker: nexus3.Docker{
 			ForceBasicAuth: false,
 			HTTPPort:       tools.GetIntPointer(rand.Intn(999) + 34000),
 			HTTPSPort:      tools.GetIntPointer(rand.Intn(999) + 35000),
@@ -43,10 +46,10 @@ func testAccResourceRepositoryDockerProxy() repository.DockerProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -72,7 +75,7 @@ func testAccResourceRepositoryDockerProxy() repository.DockerProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryDockerProxyConfig(repo repository.DockerProxyRepository) string {
+func testAccResourceRepositoryDockerProxyConfig(repo nexus3.DockerProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryDockerProxyTemplate := template.Must(template.New("DockerProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryDockerProxy))
 	if err := resourceRepositoryDockerProxyTemplate.Execute(buf, repo); err != nil {
@@ -143,6 +146,9 @@ func TestAccResourceRepositoryDockerProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "docker.0.https_port", strconv.Itoa(*repo.Docker.HTTPSPort)),
 						resource.TestCheckResourceAttr(resourceName, "docker.0.v1_enabled", strconv.FormatBool(repo.Docker.V1Enabled)),
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.#", "1"),
+						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.cache_foreign_layers", strconv.FormatBool(*repo.DockerProxy.CacheForeignLayers)),
+						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.foreign_layer_url_whitelist.#", "1"),
+						resource.TestCheckTypeSetElemAttr(resourceName, "docker_proxy.0.foreign_layer_url_whitelist.*", repo.DockerProxy.ForeignLayerURLWhitelist[0]),
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.index_type", string(repo.DockerProxy.IndexType)),
 						resource.TestCheckResourceAttr(resourceName, "docker_proxy.0.index_url", *repo.DockerProxy.IndexURL),
 					),
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryFirewall() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature, requires a configured IQ Server connection
+
+Use this resource to enable Firewall audit and quarantine on a proxy repository.`,
+
+		Create: resourceRepositoryFirewallCreate,
+		Read:   resourceRepositoryFirewallRead,
+		Update: resourceRepositoryFirewallUpdate,
+		Delete: resourceRepositoryFirewallDelete,
+		Exists: resourceRepositoryFirewallExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "The name of the proxy repository",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"audit_enabled": {
+				Description: "Whether components of the repository are audited by IQ Server",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"quarantine": {
+				Description: "Whether components violating a policy are quarantined. Requires `audit_enabled`",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func getRepositoryFirewallFromResourceData(d *schema.ResourceData) nexus3.FirewallAuditAndQuarantine {
+	return nexus3.FirewallAuditAndQuarantine{
+		RepositoryName: d.Get("repository").(string),
+		AuditEnabled:   d.Get("audit_enabled").(bool),
+		Quarantine:     d.Get("quarantine").(bool),
+	}
+}
+
+func resourceRepositoryFirewallCreate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	config := getRepositoryFirewallFromResourceData(d)
+
+	if err := client.Firewall.Update(config); err != nil {
+		return err
+	}
+
+	d.SetId(config.RepositoryName)
+	return resourceRepositoryFirewallRead(d, m)
+}
+
+func resourceRepositoryFirewallRead(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	config, err := client.Firewall.Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if config == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository", config.RepositoryName)
+	d.Set("audit_enabled", config.AuditEnabled)
+	d.Set("quarantine", config.Quarantine)
+
+	return nil
+}
+
+func resourceRepositoryFirewallUpdate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	config := getRepositoryFirewallFromResourceData(d)
+	if err := client.Firewall.Update(config); err != nil {
+		return err
+	}
+
+	return resourceRepositoryFirewallRead(d, m)
+}
+
+func resourceRepositoryFirewallDelete(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	// The repository itself is not owned by this resource, so audit and
+	// quarantine are just disabled
+	config := nexus3.FirewallAuditAndQuarantine{
+		RepositoryName: d.Id(),
+		AuditEnabled:   false,
+		Quarantine:     false,
+	}
+	if err := client.Firewall.Update(config); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRepositoryFirewallExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	config, err := client.Firewall.Get(d.Id())
+	return config != nil, err
+}
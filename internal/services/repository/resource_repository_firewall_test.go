@@ -0,0 +1,58 @@
+package repository_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryFirewallConfig(config nexus3.FirewallAuditAndQuarantine) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_firewall" "acceptance" {
+	repository    = nexus_repository_npm_proxy.acceptance.name
+	audit_enabled = %t
+	quarantine    = %t
+}
+`, config.AuditEnabled, config.Quarantine)
+}
+
+func TestAccResourceRepositoryFirewall(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	repo := testAccResourceRepositoryNpmProxy()
+	config := nexus3.FirewallAuditAndQuarantine{
+		RepositoryName: repo.Name,
+		AuditEnabled:   true,
+		Quarantine:     true,
+	}
+	resourceName := "nexus_repository_firewall.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryNpmProxyConfig(repo) + testAccResourceRepositoryFirewallConfig(config),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", config.RepositoryName),
+					resource.TestCheckResourceAttr(resourceName, "repository", config.RepositoryName),
+					resource.TestCheckResourceAttr(resourceName, "audit_enabled", strconv.FormatBool(config.AuditEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "quarantine", strconv.FormatBool(config.Quarantine)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     config.RepositoryName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -3,20 +3,22 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryGoGroup() *schema.Resource {
-	return &schema.Resource{
+	return withGroupStateUpgrade(&schema.Resource{
 		Description: "Use this resource to create a group go repository.",
 
-		Create: resourceGoGroupRepositoryCreate,
-		Delete: resourceGoGroupRepositoryDelete,
-		Exists: resourceGoGroupRepositoryExists,
-		Read:   resourceGoGroupRepositoryRead,
-		Update: resourceGoGroupRepositoryUpdate,
+		CustomizeDiff: validateBlobStore,
+		Create:        resourceGoGroupRepositoryCreate,
+		Delete:        resourceGoGroupRepositoryDelete,
+		Exists:        resourceGoGroupRepositoryExists,
+		Read:          resourceGoGroupRepositoryRead,
+		Update:        resourceGoGroupRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,18 +28,19 @@ func ResourceRepositoryGoGroup() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
 		},
-	}
+	})
 }
 
 func getGoGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.GoGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
@@ -86,19 +89,19 @@ func resourceGoGroupRepositoryCreate(resourceData *schema.ResourceData, m interf
 }
 
 func resourceGoGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Go.Group.Get(resourceData.Id())
+	var repo repository.GoGroupRepository
+	url, err := client.Repository.GetWithURL("go", "group", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setGoGroupRepositoryToResourceData(repo, resourceData)
+	return setGoGroupRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceGoGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryGoProxy() repository.GoProxyRepository {
+func testAccResourceRepositoryGoProxy() nexus3.GoProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.GoProxyRepository{
+	return nexus3.GoProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryGoProxy() repository.GoProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClientWithPreemptiveAuth{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthenticationWithPreemptive{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -60,7 +61,7 @@ func testAccResourceRepositoryGoProxy() repository.GoProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryGoProxyConfig(repo repository.GoProxyRepository) string {
+func testAccResourceRepositoryGoProxyConfig(repo nexus3.GoProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryGoProxyTemplate := template.Must(template.New("GoProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryGoProxy))
 	if err := resourceRepositoryGoProxyTemplate.Execute(buf, repo); err != nil {
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Memberships of the same group are changed by read-modify-write requests,
+// which must not run in parallel
+var groupMembershipMutex sync.Mutex
+
+func ResourceRepositoryGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to add members to an existing group repository of any format.
+
+Only the given members are managed, other members of the group are left untouched. New members are appended to the end of the group.
+
+~> The group must not be managed with ` + "`member_names`" + ` of a group repository resource at the same time, otherwise both resources remove the members of each other.`,
+
+		Create: resourceRepositoryGroupMembershipCreate,
+		Read:   resourceRepositoryGroupMembershipRead,
+		Update: resourceRepositoryGroupMembershipUpdate,
+		Delete: resourceRepositoryGroupMembershipDelete,
+		Exists: resourceRepositoryGroupMembershipExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRepositoryGroupMembershipImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "The name of the group repository",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"member_names": {
+				Description: "Member repositories' names which are added to the group",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+				Type:     schema.TypeSet,
+			},
+		},
+	}
+}
+
+func updateRepositoryGroupMembers(client *nexus3.NexusClient, name string, add []string, remove []string) error {
+	groupMembershipMutex.Lock()
+	defer groupMembershipMutex.Unlock()
+
+	memberNames, err := client.Repository.Group.GetMemberNames(name)
+	if err != nil {
+		return err
+	}
+
+	removed := map[string]bool{}
+	for _, memberName := range remove {
+		removed[memberName] = true
+	}
+
+	newMemberNames := []string{}
+	existing := map[string]bool{}
+	for _, memberName := range memberNames {
+		if !removed[memberName] {
+			newMemberNames = append(newMemberNames, memberName)
+			existing[memberName] = true
+		}
+	}
+	for _, memberName := range add {
+		if !existing[memberName] {
+			newMemberNames = append(newMemberNames, memberName)
+			existing[memberName] = true
+		}
+	}
+
+	return client.Repository.Group.SetMemberNames(name, newMemberNames)
+}
+
+func resourceRepositoryGroupMembershipCreate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	name := d.Get("repository").(string)
+	memberNames := tools.InterfaceSliceToStringSlice(d.Get("member_names").(*schema.Set).List())
+
+	if err := updateRepositoryGroupMembers(client, name, memberNames, nil); err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return resourceRepositoryGroupMembershipRead(d, m)
+}
+
+func resourceRepositoryGroupMembershipRead(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	memberNames, err := client.Repository.Group.GetMemberNames(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if memberNames == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Only report managed members
+	managed := d.Get("member_names").(*schema.Set)
+	foundMemberNames := []string{}
+	for _, memberName := range memberNames {
+		if managed.Contains(memberName) {
+			foundMemberNames = append(foundMemberNames, memberName)
+		}
+	}
+
+	d.Set("repository", d.Id())
+	d.Set("member_names", tools.StringSliceToInterfaceSlice(foundMemberNames))
+
+	return nil
+}
+
+func resourceRepositoryGroupMembershipUpdate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	if d.HasChange("member_names") {
+		oldMemberNames, newMemberNames := d.GetChange("member_names")
+		add := newMemberNames.(*schema.Set).Difference(oldMemberNames.(*schema.Set))
+		remove := oldMemberNames.(*schema.Set).Difference(newMemberNames.(*schema.Set))
+
+		if err := updateRepositoryGroupMembers(client, d.Id(), tools.InterfaceSliceToStringSlice(add.List()), tools.InterfaceSliceToStringSlice(remove.List())); err != nil {
+			return err
+		}
+	}
+
+	return resourceRepositoryGroupMembershipRead(d, m)
+}
+
+func resourceRepositoryGroupMembershipDelete(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	memberNames := tools.InterfaceSliceToStringSlice(d.Get("member_names").(*schema.Set).List())
+
+	if err := updateRepositoryGroupMembers(client, d.Id(), nil, memberNames); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceRepositoryGroupMembershipImport adopts all current members of the group
+func resourceRepositoryGroupMembershipImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	memberNames, err := client.Repository.Group.GetMemberNames(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if memberNames == nil {
+		return nil, fmt.Errorf("group repository '%s' does not exist", d.Id())
+	}
+
+	d.Set("member_names", tools.StringSliceToInterfaceSlice(memberNames))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceRepositoryGroupMembershipExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	memberNames, err := client.Repository.Group.GetMemberNames(d.Id())
+	return memberNames != nil, err
+}
@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccResourceRepositoryGroupMembershipConfig(groupName string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_raw_group" "acceptance" {
+	name   = "%s"
+	online = true
+
+	group {
+		member_names = [nexus_repository_raw_hosted.acceptance.name]
+	}
+
+	storage {
+		blob_store_name = "default"
+	}
+
+	# Members are contributed by nexus_repository_group_membership
+	lifecycle {
+		ignore_changes = [group]
+	}
+}
+
+resource "nexus_repository_group_membership" "acceptance" {
+	repository   = nexus_repository_raw_group.acceptance.name
+	member_names = [nexus_repository_raw_proxy.acceptance.name]
+}
+`, groupName)
+}
+
+func TestAccResourceRepositoryGroupMembership(t *testing.T) {
+	repoHosted := testAccResourceRepositoryRawHosted()
+	repoProxy := testAccResourceRepositoryRawProxy()
+	groupName := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+	resourceName := "nexus_repository_group_membership.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryRawHostedConfig(repoHosted) + testAccResourceRepositoryRawProxyConfig(repoProxy) + testAccResourceRepositoryGroupMembershipConfig(groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", groupName),
+					resource.TestCheckResourceAttr(resourceName, "repository", groupName),
+					resource.TestCheckResourceAttr(resourceName, "member_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "member_names.*", repoProxy.Name),
+				),
+			},
+			{
+				// Import adopts all members of the group
+				ResourceName:  resourceName,
+				ImportStateId: groupName,
+				ImportState:   true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if memberCount := states[0].Attributes["member_names.#"]; memberCount != "2" {
+						return fmt.Errorf("expected 2 imported members, got %s", memberCount)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
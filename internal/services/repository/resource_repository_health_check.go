@@ -0,0 +1,74 @@
+package repository
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryHealthCheck() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to enable Repository Health Check on a proxy repository.
+
+Destroying the resource disables Repository Health Check again. The API does not report the state of Repository Health Check, so changes made outside of Terraform are not detected.`,
+
+		Create: resourceRepositoryHealthCheckCreate,
+		Read:   resourceRepositoryHealthCheckRead,
+		Delete: resourceRepositoryHealthCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "The name of the proxy repository",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceRepositoryHealthCheckCreate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	name := d.Get("repository").(string)
+
+	if err := client.Repository.EnableHealthCheck(name); err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return resourceRepositoryHealthCheckRead(d, m)
+}
+
+func resourceRepositoryHealthCheckRead(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	info, err := client.Repository.Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if info == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository", info.Name)
+
+	return nil
+}
+
+func resourceRepositoryHealthCheckDelete(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	if err := client.Repository.DisableHealthCheck(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,41 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryHealthCheckConfig() string {
+	return `
+resource "nexus_repository_health_check" "acceptance" {
+	repository = nexus_repository_npm_proxy.acceptance.name
+}
+`
+}
+
+func TestAccResourceRepositoryHealthCheck(t *testing.T) {
+	repo := testAccResourceRepositoryNpmProxy()
+	resourceName := "nexus_repository_health_check.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryNpmProxyConfig(repo) + testAccResourceRepositoryHealthCheckConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "repository", repo.Name),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     repo.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
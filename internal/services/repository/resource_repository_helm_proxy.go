@@ -3,9 +3,11 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,11 +15,12 @@ func ResourceRepositoryHelmProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a helm proxy repository.",
 
-		Create: resourceHelmProxyRepositoryCreate,
-		Delete: resourceHelmProxyRepositoryDelete,
-		Exists: resourceHelmProxyRepositoryExists,
-		Read:   resourceHelmProxyRepositoryRead,
-		Update: resourceHelmProxyRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceHelmProxyRepositoryCreate,
+		Delete:        resourceHelmProxyRepositoryDelete,
+		Exists:        resourceHelmProxyRepositoryExists,
+		Read:          resourceHelmProxyRepositoryRead,
+		Update:        resourceHelmProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,38 +30,36 @@ func ResourceRepositoryHelmProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClientWithPreemptiveAuth,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
 		},
 	}
 }
 
-func getHelmProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.HelmProxyRepository {
+func getHelmProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.HelmProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.HelmProxyRepository{
+	repo := nexus3.HelmProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClientWithPreemptiveAuth{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -89,12 +90,18 @@ func getHelmProxyRepositoryFromResourceData(resourceData *schema.ResourceData) r
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthenticationWithPreemptive{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
+			}
+
+			preemptive, ok := authConfig["preemptive"]
+			if ok {
+				repo.HTTPClient.Authentication.Preemptive = tools.GetBoolPointer(preemptive.(bool))
 			}
 		}
 	}
@@ -103,10 +110,12 @@ func getHelmProxyRepositoryFromResourceData(resourceData *schema.ResourceData) r
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setHelmProxyRepositoryToResourceData(repo *repository.HelmProxyRepository, resourceData *schema.ResourceData) error {
+func setHelmProxyRepositoryToResourceData(repo *nexus3.HelmProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -115,13 +124,15 @@ func setHelmProxyRepositoryToResourceData(repo *repository.HelmProxyRepository,
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
 		return err
 	}
 
-	if err := resourceData.Set("http_client", flattenHTTPClient(&repo.HTTPClient, resourceData)); err != nil {
+	if err := resourceData.Set("http_client", flattenHTTPClientWithPreemptiveAuth(&repo.HTTPClient, resourceData)); err != nil {
 		return err
 	}
 
@@ -138,11 +149,15 @@ func setHelmProxyRepositoryToResourceData(repo *repository.HelmProxyRepository,
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceHelmProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getHelmProxyRepositoryFromResourceData(resourceData)
 
@@ -155,7 +170,7 @@ func resourceHelmProxyRepositoryCreate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceHelmProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Helm.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -167,11 +182,15 @@ func resourceHelmProxyRepositoryRead(resourceData *schema.ResourceData, m interf
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setHelmProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceHelmProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getHelmProxyRepositoryFromResourceData(resourceData)
@@ -184,12 +203,12 @@ func resourceHelmProxyRepositoryUpdate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceHelmProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Helm.Proxy.Delete(resourceData.Id())
 }
 
 func resourceHelmProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Helm.Proxy.Get(resourceData.Id())
 	return repo != nil, err
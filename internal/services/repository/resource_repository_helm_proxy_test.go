@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryHelmProxy() repository.HelmProxyRepository {
+func testAccResourceRepositoryHelmProxy() nexus3.HelmProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.HelmProxyRepository{
+	return nexus3.HelmProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryHelmProxy() repository.HelmProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClientWithPreemptiveAuth{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthenticationWithPreemptive{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -55,12 +56,12 @@ func testAccResourceRepositoryHelmProxy() repository.HelmProxyRepository {
 		Proxy: repository.Proxy{
 			ContentMaxAge:  770,
 			MetadataMaxAge: 770,
-			RemoteURL:      "https://kubernetes-charts.storage.googleapis.com/",
+			RemoteURL:      "https://charts.bitnami.com/bitnami",
 		},
 	}
 }
 
-func testAccResourceRepositoryHelmProxyConfig(repo repository.HelmProxyRepository) string {
+func testAccResourceRepositoryHelmProxyConfig(repo nexus3.HelmProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryHelmProxyTemplate := template.Must(template.New("HelmProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryHelmProxy))
 	if err := resourceRepositoryHelmProxyTemplate.Execute(buf, repo); err != nil {
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceRepositoryIndexRebuild() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to rebuild the search index of a repository, e.g. after a restore or a blob store migration.
+
+The index is rebuilt on creation and whenever ` + "`triggers`" + ` change. Destroying the resource has no effect on the repository.`,
+
+		Create: resourceRepositoryIndexRebuildCreate,
+		Read:   resourceRepositoryIndexRebuildRead,
+		Delete: resourceRepositoryIndexRebuildDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"repository": {
+				Description: "The name of the repository",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values which rebuild the index again when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ForceNew: true,
+				Optional: true,
+				Type:     schema.TypeMap,
+			},
+		},
+	}
+}
+
+func resourceRepositoryIndexRebuildCreate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	name := d.Get("repository").(string)
+
+	if err := client.Repository.RebuildIndex(name); err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	return resourceRepositoryIndexRebuildRead(d, m)
+}
+
+func resourceRepositoryIndexRebuildRead(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	info, err := client.Repository.Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if info == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository", info.Name)
+
+	return nil
+}
+
+func resourceRepositoryIndexRebuildDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
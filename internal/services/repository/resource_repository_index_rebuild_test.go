@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceRepositoryIndexRebuildConfig(trigger string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_index_rebuild" "acceptance" {
+	repository = nexus_repository_raw_hosted.acceptance.name
+	triggers = {
+		run = "%s"
+	}
+}
+`, trigger)
+}
+
+func TestAccResourceRepositoryIndexRebuild(t *testing.T) {
+	repo := testAccResourceRepositoryRawHosted()
+	resourceName := "nexus_repository_index_rebuild.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryRawHostedConfig(repo) + testAccResourceRepositoryIndexRebuildConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "repository", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "first"),
+				),
+			},
+			{
+				Config: testAccResourceRepositoryRawHostedConfig(repo) + testAccResourceRepositoryIndexRebuildConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "second"),
+				),
+			},
+		},
+	})
+}
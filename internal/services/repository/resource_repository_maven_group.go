@@ -3,20 +3,22 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryMavenGroup() *schema.Resource {
-	return &schema.Resource{
+	return withGroupStateUpgrade(&schema.Resource{
 		Description: "Use this resource to create a group maven repository.",
 
-		Create: resourceMavenGroupRepositoryCreate,
-		Delete: resourceMavenGroupRepositoryDelete,
-		Exists: resourceMavenGroupRepositoryExists,
-		Read:   resourceMavenGroupRepositoryRead,
-		Update: resourceMavenGroupRepositoryUpdate,
+		CustomizeDiff: validateBlobStore,
+		Create:        resourceMavenGroupRepositoryCreate,
+		Delete:        resourceMavenGroupRepositoryDelete,
+		Exists:        resourceMavenGroupRepositoryExists,
+		Read:          resourceMavenGroupRepositoryRead,
+		Update:        resourceMavenGroupRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,18 +28,19 @@ func ResourceRepositoryMavenGroup() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
 		},
-	}
+	})
 }
 
 func getMavenGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.MavenGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
@@ -86,19 +89,19 @@ func resourceMavenGroupRepositoryCreate(resourceData *schema.ResourceData, m int
 }
 
 func resourceMavenGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Maven.Group.Get(resourceData.Id())
+	var repo repository.MavenGroupRepository
+	url, err := client.Repository.GetWithURL("maven", "group", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setMavenGroupRepositoryToResourceData(repo, resourceData)
+	return setMavenGroupRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceMavenGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
@@ -3,9 +3,11 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,13 +15,14 @@ func ResourceRepositoryMavenHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted maven repository.",
 
-		Create: resourceMavenHostedRepositoryCreate,
-		Delete: resourceMavenHostedRepositoryDelete,
-		Exists: resourceMavenHostedRepositoryExists,
-		Read:   resourceMavenHostedRepositoryRead,
-		Update: resourceMavenHostedRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceMavenHostedRepositoryCreate,
+		Delete:        resourceMavenHostedRepositoryDelete,
+		Exists:        resourceMavenHostedRepositoryExists,
+		Read:          resourceMavenHostedRepositoryRead,
+		Update:        resourceMavenHostedRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHostedRepositoryState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,10 +30,12 @@ func ResourceRepositoryMavenHosted() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Hosted schemas
-			"cleanup":   repositorySchema.ResourceCleanup,
-			"component": repositorySchema.ResourceComponent,
-			"storage":   repositorySchema.ResourceHostedStorage,
+			"cleanup":       repositorySchema.ResourceCleanup,
+			"component":     repositorySchema.ResourceComponent,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
+			"storage":       repositorySchema.ResourceHostedStorage,
 			// Maven hosted schemas
 			"maven": repositorySchema.ResourceMaven,
 		},
@@ -129,19 +134,19 @@ func resourceMavenHostedRepositoryCreate(resourceData *schema.ResourceData, m in
 }
 
 func resourceMavenHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Maven.Hosted.Get(resourceData.Id())
+	var repo repository.MavenHostedRepository
+	url, err := client.Repository.GetWithURL("maven", "hosted", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setMavenHostedRepositoryToResourceData(repo, resourceData)
+	return setMavenHostedRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceMavenHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
@@ -158,6 +163,10 @@ func resourceMavenHostedRepositoryUpdate(resourceData *schema.ResourceData, m in
 }
 
 func resourceMavenHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	if err := validateForceDestroy(resourceData, m); err != nil {
+		return err
+	}
+
 	client := m.(*nexus.NexusClient)
 	return client.Repository.Maven.Hosted.Delete(resourceData.Id())
 }
@@ -3,9 +3,11 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,11 +15,12 @@ func ResourceRepositoryMavenProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a maven proxy repository.",
 
-		Create: resourceMavenProxyRepositoryCreate,
-		Delete: resourceMavenProxyRepositoryDelete,
-		Exists: resourceMavenProxyRepositoryExists,
-		Read:   resourceMavenProxyRepositoryRead,
-		Update: resourceMavenProxyRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceMavenProxyRepositoryCreate,
+		Delete:        resourceMavenProxyRepositoryDelete,
+		Exists:        resourceMavenProxyRepositoryExists,
+		Read:          resourceMavenProxyRepositoryRead,
+		Update:        resourceMavenProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,11 +30,13 @@ func ResourceRepositoryMavenProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClientWithPreemptiveAuth,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
 			// Maven proxy schemas
@@ -40,28 +45,24 @@ func ResourceRepositoryMavenProxy() *schema.Resource {
 	}
 }
 
-func getMavenProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.MavenProxyRepository {
+func getMavenProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.MavenProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	mavenConfig := resourceData.Get("maven").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.MavenProxyRepository{
+	repo := nexus3.MavenProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		HTTPClient: repository.HTTPClientWithPreemptiveAuth{
+		HTTPClient: nexus3.HTTPClientWithPreemptiveAuth{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -101,12 +102,13 @@ func getMavenProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthenticationWithPreemptive{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthenticationWithPreemptive{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
 			}
 
 			preemptive, ok := authConfig["preemptive"]
@@ -120,10 +122,12 @@ func getMavenProxyRepositoryFromResourceData(resourceData *schema.ResourceData)
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setMavenProxyRepositoryToResourceData(repo *repository.MavenProxyRepository, resourceData *schema.ResourceData) error {
+func setMavenProxyRepositoryToResourceData(repo *nexus3.MavenProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -132,6 +136,8 @@ func setMavenProxyRepositoryToResourceData(repo *repository.MavenProxyRepository
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("maven", flattenMaven(&repo.Maven)); err != nil {
@@ -159,11 +165,15 @@ func setMavenProxyRepositoryToResourceData(repo *repository.MavenProxyRepository
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceMavenProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getMavenProxyRepositoryFromResourceData(resourceData)
 
@@ -176,7 +186,7 @@ func resourceMavenProxyRepositoryCreate(resourceData *schema.ResourceData, m int
 }
 
 func resourceMavenProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Maven.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -188,11 +198,15 @@ func resourceMavenProxyRepositoryRead(resourceData *schema.ResourceData, m inter
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setMavenProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceMavenProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getMavenProxyRepositoryFromResourceData(resourceData)
@@ -205,12 +219,12 @@ func resourceMavenProxyRepositoryUpdate(resourceData *schema.ResourceData, m int
 }
 
 func resourceMavenProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Maven.Proxy.Delete(resourceData.Id())
 }
 
 func resourceMavenProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Maven.Proxy.Get(resourceData.Id())
 	return repo != nil, err
@@ -10,11 +10,13 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryMavenProxy() repository.MavenProxyRepository {
+func testAccResourceRepositoryMavenProxy() nexus3.MavenProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
@@ -22,7 +24,7 @@ func testAccResourceRepositoryMavenProxy() repository.MavenProxyRepository {
 	contentDisposition := repository.MavenContentDispositionAttachment
 	preemptive := true
 
-	return repository.MavenProxyRepository{
+	return nexus3.MavenProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -32,10 +34,10 @@ func testAccResourceRepositoryMavenProxy() repository.MavenProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClientWithPreemptiveAuth{
+		HTTPClient: nexus3.HTTPClientWithPreemptiveAuth{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthenticationWithPreemptive{
+			Authentication: &nexus3.HTTPClientAuthenticationWithPreemptive{
 				Password:   "acceptance-password",
 				Type:       repository.HTTPClientAuthenticationTypeUsername,
 				Username:   "acceptance-user",
@@ -66,7 +68,7 @@ func testAccResourceRepositoryMavenProxy() repository.MavenProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryMavenProxyConfig(repo repository.MavenProxyRepository) string {
+func testAccResourceRepositoryMavenProxyConfig(repo nexus3.MavenProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryMavenProxyTemplate := template.Must(template.New("MavenProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryMavenProxy))
 	if err := resourceRepositoryMavenProxyTemplate.Execute(buf, repo); err != nil {
@@ -146,3 +148,39 @@ func TestAccResourceRepositoryMavenProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryMavenProxyReplication(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	repo := testAccResourceRepositoryMavenProxy()
+	repo.Replication = &nexus3.Replication{
+		AssetPathRegex:        tools.GetStringPointer("^/org/example/.*"),
+		PreemptivePullEnabled: true,
+	}
+	resourceName := "nexus_repository_maven_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryMavenProxyConfig(repo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "replication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication.0.asset_path_regex", *repo.Replication.AssetPathRegex),
+					resource.TestCheckResourceAttr(resourceName, "replication.0.preemptive_pull_enabled", strconv.FormatBool(repo.Replication.PreemptivePullEnabled)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           repo.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.password"},
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
@@ -10,14 +11,15 @@ import (
 )
 
 func ResourceRepositoryNpmGroup() *schema.Resource {
-	return &schema.Resource{
+	return withGroupStateUpgrade(&schema.Resource{
 		Description: "Use this resource to create a group npm repository.",
 
-		Create: resourceNpmGroupRepositoryCreate,
-		Delete: resourceNpmGroupRepositoryDelete,
-		Exists: resourceNpmGroupRepositoryExists,
-		Read:   resourceNpmGroupRepositoryRead,
-		Update: resourceNpmGroupRepositoryUpdate,
+		CustomizeDiff: validateBlobStore,
+		Create:        resourceNpmGroupRepositoryCreate,
+		Delete:        resourceNpmGroupRepositoryDelete,
+		Exists:        resourceNpmGroupRepositoryExists,
+		Read:          resourceNpmGroupRepositoryRead,
+		Update:        resourceNpmGroupRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,18 +29,19 @@ func ResourceRepositoryNpmGroup() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroupDeploy,
 			"storage": repositorySchema.ResourceStorage,
 		},
-	}
+	})
 }
 
 func getNpmGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.NpmGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
@@ -91,19 +94,19 @@ func resourceNpmGroupRepositoryCreate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceNpmGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Npm.Group.Get(resourceData.Id())
+	var repo repository.NpmGroupRepository
+	url, err := client.Repository.GetWithURL("npm", "group", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setNpmGroupRepositoryToResourceData(repo, resourceData)
+	return setNpmGroupRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceNpmGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
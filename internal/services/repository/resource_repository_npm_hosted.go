@@ -3,9 +3,11 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,13 +15,14 @@ func ResourceRepositoryNpmHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted Npm repository.",
 
-		Create: resourceNpmHostedRepositoryCreate,
-		Delete: resourceNpmHostedRepositoryDelete,
-		Exists: resourceNpmHostedRepositoryExists,
-		Read:   resourceNpmHostedRepositoryRead,
-		Update: resourceNpmHostedRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceNpmHostedRepositoryCreate,
+		Delete:        resourceNpmHostedRepositoryDelete,
+		Exists:        resourceNpmHostedRepositoryExists,
+		Read:          resourceNpmHostedRepositoryRead,
+		Update:        resourceNpmHostedRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHostedRepositoryState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,10 +30,12 @@ func ResourceRepositoryNpmHosted() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Hosted schemas
-			"cleanup":   repositorySchema.ResourceCleanup,
-			"component": repositorySchema.ResourceComponent,
-			"storage":   repositorySchema.ResourceHostedStorage,
+			"cleanup":       repositorySchema.ResourceCleanup,
+			"component":     repositorySchema.ResourceComponent,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
+			"storage":       repositorySchema.ResourceHostedStorage,
 		},
 	}
 }
@@ -113,19 +118,19 @@ func resourceNpmHostedRepositoryCreate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceNpmHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Npm.Hosted.Get(resourceData.Id())
+	var repo repository.NpmHostedRepository
+	url, err := client.Repository.GetWithURL("npm", "hosted", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setNpmHostedRepositoryToResourceData(repo, resourceData)
+	return setNpmHostedRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceNpmHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
@@ -142,6 +147,10 @@ func resourceNpmHostedRepositoryUpdate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceNpmHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	if err := validateForceDestroy(resourceData, m); err != nil {
+		return err
+	}
+
 	client := m.(*nexus.NexusClient)
 	return client.Repository.Npm.Hosted.Delete(resourceData.Id())
 }
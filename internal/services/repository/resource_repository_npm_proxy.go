@@ -3,21 +3,24 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryNpmProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create an NPM proxy repository.",
-
-		Create: resourceNpmProxyRepositoryCreate,
-		Delete: resourceNpmProxyRepositoryDelete,
-		Exists: resourceNpmProxyRepositoryExists,
-		Read:   resourceNpmProxyRepositoryRead,
-		Update: resourceNpmProxyRepositoryUpdate,
+		Description: "Use this resource to create an npm proxy repository.",
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceNpmProxyRepositoryCreate,
+		Delete:        resourceNpmProxyRepositoryDelete,
+		Exists:        resourceNpmProxyRepositoryExists,
+		Read:          resourceNpmProxyRepositoryRead,
+		Update:        resourceNpmProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,11 +30,13 @@ func ResourceRepositoryNpmProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
 			// NPM proxy schemas
@@ -51,27 +56,23 @@ func ResourceRepositoryNpmProxy() *schema.Resource {
 	}
 }
 
-func getNpmProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.NpmProxyRepository {
+func getNpmProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.NpmProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.NpmProxyRepository{
+	repo := nexus3.NpmProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -106,12 +107,13 @@ func getNpmProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
 			}
 		}
 	}
@@ -120,10 +122,12 @@ func getNpmProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setNpmProxyRepositoryToResourceData(repo *repository.NpmProxyRepository, resourceData *schema.ResourceData) error {
+func setNpmProxyRepositoryToResourceData(repo *nexus3.NpmProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
@@ -132,6 +136,8 @@ func setNpmProxyRepositoryToResourceData(repo *repository.NpmProxyRepository, re
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
@@ -158,11 +164,15 @@ func setNpmProxyRepositoryToResourceData(repo *repository.NpmProxyRepository, re
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceNpmProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getNpmProxyRepositoryFromResourceData(resourceData)
 
@@ -175,7 +185,7 @@ func resourceNpmProxyRepositoryCreate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceNpmProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Npm.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -187,11 +197,15 @@ func resourceNpmProxyRepositoryRead(resourceData *schema.ResourceData, m interfa
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setNpmProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceNpmProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getNpmProxyRepositoryFromResourceData(resourceData)
@@ -204,12 +218,12 @@ func resourceNpmProxyRepositoryUpdate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceNpmProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Npm.Proxy.Delete(resourceData.Id())
 }
 
 func resourceNpmProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Npm.Proxy.Get(resourceData.Id())
 	return repo != nil, err
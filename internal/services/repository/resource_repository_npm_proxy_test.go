@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryNpmProxy() repository.NpmProxyRepository {
+func testAccResourceRepositoryNpmProxy() nexus3.NpmProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.NpmProxyRepository{
+	return nexus3.NpmProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryNpmProxy() repository.NpmProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -64,7 +65,7 @@ func testAccResourceRepositoryNpmProxy() repository.NpmProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryNpmProxyConfig(repo repository.NpmProxyRepository) string {
+func testAccResourceRepositoryNpmProxyConfig(repo nexus3.NpmProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryNpmProxyTemplate := template.Must(template.New("NpmProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryNpmProxy))
 	if err := resourceRepositoryNpmProxyTemplate.Execute(buf, repo); err != nil {
@@ -144,3 +145,35 @@ func TestAccResourceRepositoryNpmProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryNpmProxyBearerToken(t *testing.T) {
+	repo := testAccResourceRepositoryNpmProxy()
+	repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+		BearerToken: "acceptance-token",
+		Type:        nexus3.HTTPClientAuthenticationTypeBearerToken,
+	}
+	resourceName := "nexus_repository_npm_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRepositoryNpmProxyConfig(repo),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
+					resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.0.type", string(repo.HTTPClient.Authentication.Type)),
+					resource.TestCheckResourceAttr(resourceName, "http_client.0.authentication.0.bearer_token", repo.HTTPClient.Authentication.BearerToken),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportStateId:           repo.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.0.authentication.0.bearer_token"},
+			},
+		},
+	})
+}
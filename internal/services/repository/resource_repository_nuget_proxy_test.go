@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryNugetProxy() repository.NugetProxyRepository {
+func testAccResourceRepositoryNugetProxy() nexus3.NugetProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.NugetProxyRepository{
+	return nexus3.NugetProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryNugetProxy() repository.NugetProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -64,7 +65,7 @@ func testAccResourceRepositoryNugetProxy() repository.NugetProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryNugetProxyConfig(repo repository.NugetProxyRepository) string {
+func testAccResourceRepositoryNugetProxyConfig(repo nexus3.NugetProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryNugetProxyTemplate := template.Must(template.New("NugetProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryNugetProxy))
 	if err := resourceRepositoryNugetProxyTemplate.Execute(buf, repo); err != nil {
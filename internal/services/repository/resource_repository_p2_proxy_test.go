@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryP2Proxy() repository.P2ProxyRepository {
+func testAccResourceRepositoryP2Proxy() nexus3.P2ProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.P2ProxyRepository{
+	return nexus3.P2ProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryP2Proxy() repository.P2ProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -60,7 +61,7 @@ func testAccResourceRepositoryP2Proxy() repository.P2ProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryP2ProxyConfig(repo repository.P2ProxyRepository) string {
+func testAccResourceRepositoryP2ProxyConfig(repo nexus3.P2ProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryP2ProxyTemplate := template.Must(template.New("P2ProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryP2Proxy))
 	if err := resourceRepositoryP2ProxyTemplate.Execute(buf, repo); err != nil {
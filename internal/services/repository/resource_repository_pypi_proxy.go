@@ -3,21 +3,24 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryPypiProxy() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create an NPM proxy repository.",
-
-		Create: resourcePypiProxyRepositoryCreate,
-		Delete: resourcePypiProxyRepositoryDelete,
-		Exists: resourcePypiProxyRepositoryExists,
-		Read:   resourcePypiProxyRepositoryRead,
-		Update: resourcePypiProxyRepositoryUpdate,
+		Description: "Use this resource to create a pypi proxy repository.",
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourcePypiProxyRepositoryCreate,
+		Delete:        resourcePypiProxyRepositoryDelete,
+		Exists:        resourcePypiProxyRepositoryExists,
+		Read:          resourcePypiProxyRepositoryRead,
+		Update:        resourcePypiProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,43 +30,51 @@ func ResourceRepositoryPypiProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClient,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
+			// Pypi proxy schemas
+			"remove_quarantined": {
+				Description: "Remove quarantined versions from the simple index of the pypi repository.",
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
 		},
 	}
 }
 
-func getPypiProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.PypiProxyRepository {
+func getPypiProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.PypiProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.PypiProxyRepository{
+	repo := nexus3.PypiProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
 			RemoteURL:      proxyConfig["remote_url"].(string),
 		},
+		Pypi: &nexus3.Pypi{
+			RemoveQuarantined: resourceData.Get("remove_quarantined").(bool),
+		},
 	}
 
 	if routingRule, ok := resourceData.GetOk("routing_rule"); ok {
@@ -89,12 +100,13 @@ func getPypiProxyRepositoryFromResourceData(resourceData *schema.ResourceData) r
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthentication{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
 			}
 		}
 	}
@@ -103,18 +115,26 @@ func getPypiProxyRepositoryFromResourceData(resourceData *schema.ResourceData) r
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
 	return repo
 }
 
-func setPypiProxyRepositoryToResourceData(repo *repository.PypiProxyRepository, resourceData *schema.ResourceData) error {
+func setPypiProxyRepositoryToResourceData(repo *nexus3.PypiProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
 
+	if repo.Pypi != nil {
+		resourceData.Set("remove_quarantined", repo.RemoveQuarantined)
+	}
+
 	if repo.RoutingRuleName != nil {
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
@@ -138,11 +158,15 @@ func setPypiProxyRepositoryToResourceData(repo *repository.PypiProxyRepository,
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourcePypiProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getPypiProxyRepositoryFromResourceData(resourceData)
 
@@ -155,7 +179,7 @@ func resourcePypiProxyRepositoryCreate(resourceData *schema.ResourceData, m inte
 }
 
 func resourcePypiProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Pypi.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -167,11 +191,15 @@ func resourcePypiProxyRepositoryRead(resourceData *schema.ResourceData, m interf
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setPypiProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourcePypiProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getPypiProxyRepositoryFromResourceData(resourceData)
@@ -184,12 +212,12 @@ func resourcePypiProxyRepositoryUpdate(resourceData *schema.ResourceData, m inte
 }
 
 func resourcePypiProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Pypi.Proxy.Delete(resourceData.Id())
 }
 
 func resourcePypiProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Pypi.Proxy.Get(resourceData.Id())
 	return repo != nil, err
@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryPypiProxy() repository.PypiProxyRepository {
+func testAccResourceRepositoryPypiProxy() nexus3.PypiProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.PypiProxyRepository{
+	return nexus3.PypiProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryPypiProxy() repository.PypiProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -57,10 +58,13 @@ func testAccResourceRepositoryPypiProxy() repository.PypiProxyRepository {
 			MetadataMaxAge: 770,
 			RemoteURL:      "https://pypijs.org",
 		},
+		Pypi: &nexus3.Pypi{
+			RemoveQuarantined: true,
+		},
 	}
 }
 
-func testAccResourceRepositoryPypiProxyConfig(repo repository.PypiProxyRepository) string {
+func testAccResourceRepositoryPypiProxyConfig(repo nexus3.PypiProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryPypiProxyTemplate := template.Must(template.New("PypiProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryPypiProxy))
 	if err := resourceRepositoryPypiProxyTemplate.Execute(buf, repo); err != nil {
@@ -93,6 +97,7 @@ func TestAccResourceRepositoryPypiProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+						resource.TestCheckResourceAttr(resourceName, "remove_quarantined", strconv.FormatBool(repo.Pypi.RemoveQuarantined)),
 					),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "http_client.#", "1"),
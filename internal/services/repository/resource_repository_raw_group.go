@@ -3,20 +3,22 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryRawGroup() *schema.Resource {
-	return &schema.Resource{
+	return withGroupStateUpgrade(&schema.Resource{
 		Description: "Use this resource to create a group raw repository.",
 
-		Create: resourceRawGroupRepositoryCreate,
-		Delete: resourceRawGroupRepositoryDelete,
-		Exists: resourceRawGroupRepositoryExists,
-		Read:   resourceRawGroupRepositoryRead,
-		Update: resourceRawGroupRepositoryUpdate,
+		CustomizeDiff: validateBlobStore,
+		Create:        resourceRawGroupRepositoryCreate,
+		Delete:        resourceRawGroupRepositoryDelete,
+		Exists:        resourceRawGroupRepositoryExists,
+		Read:          resourceRawGroupRepositoryRead,
+		Update:        resourceRawGroupRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,18 +28,21 @@ func ResourceRepositoryRawGroup() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
+			// Raw schemas
+			"content_disposition": repositorySchema.ResourceRawContentDisposition,
 		},
-	}
+	})
 }
 
 func getRawGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.RawGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
@@ -53,6 +58,11 @@ func getRawGroupRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		},
 	}
 
+	contentDisposition := repository.RawContentDisposition(resourceData.Get("content_disposition").(string))
+	repo.Raw = &repository.Raw{
+		ContentDisposition: &contentDisposition,
+	}
+
 	return repo
 }
 
@@ -61,6 +71,10 @@ func setRawGroupRepositoryToResourceData(repo *repository.RawGroupRepository, re
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
 
+	if repo.Raw != nil && repo.Raw.ContentDisposition != nil {
+		resourceData.Set("content_disposition", string(*repo.Raw.ContentDisposition))
+	}
+
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
 		return err
 	}
@@ -86,19 +100,19 @@ func resourceRawGroupRepositoryCreate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceRawGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Raw.Group.Get(resourceData.Id())
+	var repo repository.RawGroupRepository
+	url, err := client.Repository.GetWithURL("raw", "group", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setRawGroupRepositoryToResourceData(repo, resourceData)
+	return setRawGroupRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceRawGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
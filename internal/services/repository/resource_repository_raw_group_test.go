@@ -3,6 +3,7 @@ package repository_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
@@ -14,6 +15,7 @@ import (
 )
 
 func testAccResourceRepositoryRawGroup() repository.RawGroupRepository {
+	contentDisposition := repository.RawContentDispositionAttachment
 	return repository.RawGroupRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
@@ -24,6 +26,9 @@ func testAccResourceRepositoryRawGroup() repository.RawGroupRepository {
 		Group: repository.Group{
 			MemberNames: []string{},
 		},
+		Raw: &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		},
 	}
 }
 
@@ -53,6 +58,8 @@ func TestAccResourceRepositoryRawGroup(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+						resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("/repository/"+repo.Name+"$")),
+						resource.TestCheckResourceAttr(resourceName, "content_disposition", string(*repo.Raw.ContentDisposition)),
 					),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
@@ -3,9 +3,11 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,13 +15,14 @@ func ResourceRepositoryRawHosted() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a hosted raw repository.",
 
-		Create: resourceRawHostedRepositoryCreate,
-		Delete: resourceRawHostedRepositoryDelete,
-		Exists: resourceRawHostedRepositoryExists,
-		Read:   resourceRawHostedRepositoryRead,
-		Update: resourceRawHostedRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceRawHostedRepositoryCreate,
+		Delete:        resourceRawHostedRepositoryDelete,
+		Exists:        resourceRawHostedRepositoryExists,
+		Read:          resourceRawHostedRepositoryRead,
+		Update:        resourceRawHostedRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHostedRepositoryState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -27,10 +30,14 @@ func ResourceRepositoryRawHosted() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Hosted schemas
-			"cleanup":   repositorySchema.ResourceCleanup,
-			"component": repositorySchema.ResourceComponent,
-			"storage":   repositorySchema.ResourceHostedStorage,
+			"cleanup":       repositorySchema.ResourceCleanup,
+			"component":     repositorySchema.ResourceComponent,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
+			"storage":       repositorySchema.ResourceHostedStorage,
+			// Raw schemas
+			"content_disposition": repositorySchema.ResourceRawContentDisposition,
 		},
 	}
 }
@@ -72,6 +79,11 @@ func getRawHostedRepositoryFromResourceData(resourceData *schema.ResourceData) r
 		}
 	}
 
+	contentDisposition := repository.RawContentDisposition(resourceData.Get("content_disposition").(string))
+	repo.Raw = &repository.Raw{
+		ContentDisposition: &contentDisposition,
+	}
+
 	return repo
 }
 
@@ -80,6 +92,10 @@ func setRawHostedRepositoryToResourceData(repo *repository.RawHostedRepository,
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
 
+	if repo.Raw != nil && repo.Raw.ContentDisposition != nil {
+		resourceData.Set("content_disposition", string(*repo.Raw.ContentDisposition))
+	}
+
 	if err := resourceData.Set("storage", flattenHostedStorage(&repo.Storage)); err != nil {
 		return err
 	}
@@ -113,19 +129,19 @@ func resourceRawHostedRepositoryCreate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceRawHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Raw.Hosted.Get(resourceData.Id())
+	var repo repository.RawHostedRepository
+	url, err := client.Repository.GetWithURL("raw", "hosted", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setRawHostedRepositoryToResourceData(repo, resourceData)
+	return setRawHostedRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceRawHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
@@ -142,6 +158,10 @@ func resourceRawHostedRepositoryUpdate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceRawHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	if err := validateForceDestroy(resourceData, m); err != nil {
+		return err
+	}
+
 	client := m.(*nexus.NexusClient)
 	return client.Repository.Raw.Hosted.Delete(resourceData.Id())
 }
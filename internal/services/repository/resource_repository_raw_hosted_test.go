@@ -3,6 +3,7 @@ package repository_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
@@ -14,6 +15,7 @@ import (
 )
 
 func testAccResourceRepositoryRawHosted() repository.RawHostedRepository {
+	contentDisposition := repository.RawContentDispositionInline
 	writePolicy := repository.StorageWritePolicyAllow
 
 	return repository.RawHostedRepository{
@@ -30,6 +32,9 @@ func testAccResourceRepositoryRawHosted() repository.RawHostedRepository {
 		Component: &repository.Component{
 			ProprietaryComponents: true,
 		},
+		Raw: &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		},
 	}
 }
 
@@ -57,6 +62,9 @@ func TestAccResourceRepositoryRawHosted(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+						resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
+						resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("/repository/"+repo.Name+"$")),
+						resource.TestCheckResourceAttr(resourceName, "content_disposition", string(*repo.Raw.ContentDisposition)),
 					),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "storage.#", "1"),
@@ -80,3 +88,66 @@ func TestAccResourceRepositoryRawHosted(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryRawHostedMissingCleanupPolicy(t *testing.T) {
+	repo := testAccResourceRepositoryRawHosted()
+	repo.Cleanup.PolicyNames = append(repo.Cleanup.PolicyNames, "does-not-exist")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceRepositoryRawHostedConfig(repo),
+				ExpectError: regexp.MustCompile("cleanup policies do not exist: does-not-exist"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRepositoryRawHostedMissingBlobStore(t *testing.T) {
+	repo := testAccResourceRepositoryRawHosted()
+	repo.Storage.BlobStoreName = "does-not-exist"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceRepositoryRawHostedConfig(repo),
+				ExpectError: regexp.MustCompile("blob store 'does-not-exist' does not exist"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRepositoryRawHostedBlobStoreOfSameRun(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// The blob store does not exist at plan time
+				Config: fmt.Sprintf(`
+resource "nexus_blobstore_file" "acceptance" {
+	name = "%[1]s"
+	path = "/nexus-data/%[1]s"
+}
+
+resource "nexus_repository_raw_hosted" "acceptance" {
+	name   = "%[1]s"
+	online = true
+
+	storage {
+		blob_store_name                = nexus_blobstore_file.acceptance.name
+		strict_content_type_validation = true
+	}
+}
+`, name),
+				Check: resource.TestCheckResourceAttr("nexus_repository_raw_hosted.acceptance", "storage.0.blob_store_name", name),
+			},
+		},
+	})
+}
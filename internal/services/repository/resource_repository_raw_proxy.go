@@ -3,9 +3,11 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,11 +15,12 @@ func ResourceRepositoryRawProxy() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a raw proxy repository.",
 
-		Create: resourceRawProxyRepositoryCreate,
-		Delete: resourceRawProxyRepositoryDelete,
-		Exists: resourceRawProxyRepositoryExists,
-		Read:   resourceRawProxyRepositoryRead,
-		Update: resourceRawProxyRepositoryUpdate,
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceRawProxyRepositoryCreate,
+		Delete:        resourceRawProxyRepositoryDelete,
+		Exists:        resourceRawProxyRepositoryExists,
+		Read:          resourceRawProxyRepositoryRead,
+		Update:        resourceRawProxyRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,38 +30,38 @@ func ResourceRepositoryRawProxy() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Proxy schemas
 			"cleanup":        repositorySchema.ResourceCleanup,
 			"http_client":    repositorySchema.ResourceHTTPClientWithPreemptiveAuth,
 			"negative_cache": repositorySchema.ResourceNegativeCache,
 			"proxy":          repositorySchema.ResourceProxy,
+			"replication":    repositorySchema.ResourceReplication,
 			"routing_rule":   repositorySchema.ResourceRoutingRule,
 			"storage":        repositorySchema.ResourceStorage,
+			// Raw schemas
+			"content_disposition": repositorySchema.ResourceRawContentDisposition,
 		},
 	}
 }
 
-func getRawProxyRepositoryFromResourceData(resourceData *schema.ResourceData) repository.RawProxyRepository {
+func getRawProxyRepositoryFromResourceData(resourceData *schema.ResourceData) nexus3.RawProxyRepository {
 	httpClientConfig := resourceData.Get("http_client").([]interface{})[0].(map[string]interface{})
-	negativeCacheConfig := resourceData.Get("negative_cache").([]interface{})[0].(map[string]interface{})
 	proxyConfig := resourceData.Get("proxy").([]interface{})[0].(map[string]interface{})
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 
-	repo := repository.RawProxyRepository{
+	repo := nexus3.RawProxyRepository{
 		Name:   resourceData.Get("name").(string),
 		Online: resourceData.Get("online").(bool),
 		Storage: repository.Storage{
 			BlobStoreName:               storageConfig["blob_store_name"].(string),
 			StrictContentTypeValidation: storageConfig["strict_content_type_validation"].(bool),
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClientWithPreemptiveAuth{
 			AutoBlock: httpClientConfig["auto_block"].(bool),
 			Blocked:   httpClientConfig["blocked"].(bool),
 		},
-		NegativeCache: repository.NegativeCache{
-			Enabled: negativeCacheConfig["enabled"].(bool),
-			TTL:     negativeCacheConfig["ttl"].(int),
-		},
+		NegativeCache: getNegativeCache(resourceData.Get("negative_cache").([]interface{})),
 		Proxy: repository.Proxy{
 			ContentMaxAge:  proxyConfig["content_max_age"].(int),
 			MetadataMaxAge: proxyConfig["metadata_max_age"].(int),
@@ -89,12 +92,18 @@ func getRawProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		if len(authList) == 1 && authList[0] != nil {
 			authConfig := authList[0].(map[string]interface{})
 
-			repo.HTTPClient.Authentication = &repository.HTTPClientAuthentication{
-				NTLMDomain: authConfig["ntlm_domain"].(string),
-				NTLMHost:   authConfig["ntlm_host"].(string),
-				Type:       repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
-				Username:   authConfig["username"].(string),
-				Password:   authConfig["password"].(string),
+			repo.HTTPClient.Authentication = &nexus3.HTTPClientAuthenticationWithPreemptive{
+				BearerToken: authConfig["bearer_token"].(string),
+				NTLMDomain:  authConfig["ntlm_domain"].(string),
+				NTLMHost:    authConfig["ntlm_host"].(string),
+				Type:        repository.HTTPClientAuthenticationType(authConfig["type"].(string)),
+				Username:    authConfig["username"].(string),
+				Password:    authConfig["password"].(string),
+			}
+
+			preemptive, ok := authConfig["preemptive"]
+			if ok {
+				repo.HTTPClient.Authentication.Preemptive = tools.GetBoolPointer(preemptive.(bool))
 			}
 		}
 	}
@@ -103,25 +112,38 @@ func getRawProxyRepositoryFromResourceData(resourceData *schema.ResourceData) re
 		repo.HTTPClient.Connection = getHTTPClientConnection(v.([]interface{}))
 	}
 
+	repo.Replication = getReplication(resourceData)
+
+	contentDisposition := repository.RawContentDisposition(resourceData.Get("content_disposition").(string))
+	repo.Raw = &repository.Raw{
+		ContentDisposition: &contentDisposition,
+	}
+
 	return repo
 }
 
-func setRawProxyRepositoryToResourceData(repo *repository.RawProxyRepository, resourceData *schema.ResourceData) error {
+func setRawProxyRepositoryToResourceData(repo *nexus3.RawProxyRepository, resourceData *schema.ResourceData) error {
 	resourceData.SetId(repo.Name)
 	resourceData.Set("name", repo.Name)
 	resourceData.Set("online", repo.Online)
 
+	if repo.Raw != nil && repo.Raw.ContentDisposition != nil {
+		resourceData.Set("content_disposition", string(*repo.Raw.ContentDisposition))
+	}
+
 	if repo.RoutingRuleName != nil {
 		resourceData.Set("routing_rule", repo.RoutingRuleName)
 	} else if repo.RoutingRule != nil {
 		resourceData.Set("routing_rule", repo.RoutingRule)
+	} else {
+		resourceData.Set("routing_rule", nil)
 	}
 
 	if err := resourceData.Set("storage", flattenStorage(&repo.Storage)); err != nil {
 		return err
 	}
 
-	if err := resourceData.Set("http_client", flattenHTTPClient(&repo.HTTPClient, resourceData)); err != nil {
+	if err := resourceData.Set("http_client", flattenHTTPClientWithPreemptiveAuth(&repo.HTTPClient, resourceData)); err != nil {
 		return err
 	}
 
@@ -138,11 +160,15 @@ func setRawProxyRepositoryToResourceData(repo *repository.RawProxyRepository, re
 			return err
 		}
 	}
+
+	if err := resourceData.Set("replication", flattenReplication(repo.Replication)); err != nil {
+		return err
+	}
 	return nil
 }
 
 func resourceRawProxyRepositoryCreate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo := getRawProxyRepositoryFromResourceData(resourceData)
 
@@ -155,7 +181,7 @@ func resourceRawProxyRepositoryCreate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceRawProxyRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Raw.Proxy.Get(resourceData.Id())
 	if err != nil {
@@ -167,11 +193,15 @@ func resourceRawProxyRepositoryRead(resourceData *schema.ResourceData, m interfa
 		return nil
 	}
 
+	if err := resourceData.Set("url", repo.URL); err != nil {
+		return err
+	}
+
 	return setRawProxyRepositoryToResourceData(repo, resourceData)
 }
 
 func resourceRawProxyRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repoName := resourceData.Id()
 	repo := getRawProxyRepositoryFromResourceData(resourceData)
@@ -184,12 +214,12 @@ func resourceRawProxyRepositoryUpdate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceRawProxyRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 	return client.Repository.Raw.Proxy.Delete(resourceData.Id())
 }
 
 func resourceRawProxyRepositoryExists(resourceData *schema.ResourceData, m interface{}) (bool, error) {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
 	repo, err := client.Repository.Raw.Proxy.Get(resourceData.Id())
 	return repo != nil, err
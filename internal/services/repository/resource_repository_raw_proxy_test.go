@@ -3,6 +3,7 @@ package repository_test
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"text/template"
@@ -10,18 +11,20 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryRawProxy() repository.RawProxyRepository {
+func testAccResourceRepositoryRawProxy() nexus3.RawProxyRepository {
+	contentDisposition := repository.RawContentDispositionAttachment
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.RawProxyRepository{
+	return nexus3.RawProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +34,10 @@ func testAccResourceRepositoryRawProxy() repository.RawProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClientWithPreemptiveAuth{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthenticationWithPreemptive{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -57,10 +60,13 @@ func testAccResourceRepositoryRawProxy() repository.RawProxyRepository {
 			MetadataMaxAge: 770,
 			RemoteURL:      "https://raw.elastic.co",
 		},
+		Raw: &repository.Raw{
+			ContentDisposition: &contentDisposition,
+		},
 	}
 }
 
-func testAccResourceRepositoryRawProxyConfig(repo repository.RawProxyRepository) string {
+func testAccResourceRepositoryRawProxyConfig(repo nexus3.RawProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryRawProxyTemplate := template.Must(template.New("RawProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryRawProxy))
 	if err := resourceRepositoryRawProxyTemplate.Execute(buf, repo); err != nil {
@@ -93,6 +99,8 @@ func TestAccResourceRepositoryRawProxy(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "id", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "name", repo.Name),
 						resource.TestCheckResourceAttr(resourceName, "online", strconv.FormatBool(repo.Online)),
+						resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("/repository/"+repo.Name+"$")),
+						resource.TestCheckResourceAttr(resourceName, "content_disposition", string(*repo.Raw.ContentDisposition)),
 					),
 					resource.ComposeAggregateTestCheckFunc(
 						resource.TestCheckResourceAttr(resourceName, "http_client.#", "1"),
@@ -136,3 +144,47 @@ func TestAccResourceRepositoryRawProxy(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceRepositoryRawProxyWithoutNegativeCache(t *testing.T) {
+	name := fmt.Sprintf("test-repo-%s", acctest.RandString(10))
+	resourceName := "nexus_repository_raw_proxy.acceptance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "nexus_repository_raw_proxy" "acceptance" {
+	name = "%s"
+
+	http_client {
+		auto_block = true
+		blocked    = false
+	}
+
+	proxy {
+		remote_url = "https://raw.elastic.co"
+	}
+
+	storage {
+		blob_store_name = "default"
+	}
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "negative_cache.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "negative_cache.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "negative_cache.0.ttl", "1440"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -3,20 +3,22 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceRepositoryRubygemsGroup() *schema.Resource {
-	return &schema.Resource{
+	return withGroupStateUpgrade(&schema.Resource{
 		Description: "Use this resource to create a group rubygems repository.",
 
-		Create: resourceRubygemsGroupRepositoryCreate,
-		Delete: resourceRubygemsGroupRepositoryDelete,
-		Exists: resourceRubygemsGroupRepositoryExists,
-		Read:   resourceRubygemsGroupRepositoryRead,
-		Update: resourceRubygemsGroupRepositoryUpdate,
+		CustomizeDiff: validateBlobStore,
+		Create:        resourceRubygemsGroupRepositoryCreate,
+		Delete:        resourceRubygemsGroupRepositoryDelete,
+		Exists:        resourceRubygemsGroupRepositoryExists,
+		Read:          resourceRubygemsGroupRepositoryRead,
+		Update:        resourceRubygemsGroupRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,18 +28,19 @@ func ResourceRepositoryRubygemsGroup() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
 		},
-	}
+	})
 }
 
 func getRubygemsGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.RubyGemsGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
@@ -86,19 +89,19 @@ func resourceRubygemsGroupRepositoryCreate(resourceData *schema.ResourceData, m
 }
 
 func resourceRubygemsGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.RubyGems.Group.Get(resourceData.Id())
+	var repo repository.RubyGemsGroupRepository
+	url, err := client.Repository.GetWithURL("rubygems", "group", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setRubygemsGroupRepositoryToResourceData(repo, resourceData)
+	return setRubygemsGroupRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceRubygemsGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
@@ -10,18 +10,19 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryRubygemsProxy() repository.RubyGemsProxyRepository {
+func testAccResourceRepositoryRubygemsProxy() nexus3.RubyGemsProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.RubyGemsProxyRepository{
+	return nexus3.RubyGemsProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		Storage: repository.Storage{
@@ -31,10 +32,10 @@ func testAccResourceRepositoryRubygemsProxy() repository.RubyGemsProxyRepository
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -60,7 +61,7 @@ func testAccResourceRepositoryRubygemsProxy() repository.RubyGemsProxyRepository
 	}
 }
 
-func testAccResourceRepositoryRubygemsProxyConfig(repo repository.RubyGemsProxyRepository) string {
+func testAccResourceRepositoryRubygemsProxyConfig(repo nexus3.RubyGemsProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryRubygemsProxyTemplate := template.Must(template.New("RubygemsProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryRubygemsProxy))
 	if err := resourceRepositoryRubygemsProxyTemplate.Execute(buf, repo); err != nil {
@@ -3,6 +3,7 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
@@ -10,14 +11,15 @@ import (
 )
 
 func ResourceRepositoryYumGroup() *schema.Resource {
-	return &schema.Resource{
+	return withGroupStateUpgrade(&schema.Resource{
 		Description: "Use this resource to create a group yum repository.",
 
-		Create: resourceYumGroupRepositoryCreate,
-		Delete: resourceYumGroupRepositoryDelete,
-		Exists: resourceYumGroupRepositoryExists,
-		Read:   resourceYumGroupRepositoryRead,
-		Update: resourceYumGroupRepositoryUpdate,
+		CustomizeDiff: validateBlobStore,
+		Create:        resourceYumGroupRepositoryCreate,
+		Delete:        resourceYumGroupRepositoryDelete,
+		Exists:        resourceYumGroupRepositoryExists,
+		Read:          resourceYumGroupRepositoryRead,
+		Update:        resourceYumGroupRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,20 +29,21 @@ func ResourceRepositoryYumGroup() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Group schemas
 			"group":   repositorySchema.ResourceGroup,
 			"storage": repositorySchema.ResourceStorage,
 			// Yum group schemas
 			"yum_signing": repositorySchema.ResourceYumSigning,
 		},
-	}
+	})
 }
 
 func getYumGroupRepositoryFromResourceData(resourceData *schema.ResourceData) repository.YumGroupRepository {
 	storageConfig := resourceData.Get("storage").([]interface{})[0].(map[string]interface{})
 	groupConfig := resourceData.Get("group").([]interface{})[0].(map[string]interface{})
 	groupMemberNames := []string{}
-	for _, name := range groupConfig["member_names"].(*schema.Set).List() {
+	for _, name := range groupConfig["member_names"].([]interface{}) {
 		groupMemberNames = append(groupMemberNames, name.(string))
 	}
 
@@ -101,19 +104,19 @@ func resourceYumGroupRepositoryCreate(resourceData *schema.ResourceData, m inter
 }
 
 func resourceYumGroupRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Yum.Group.Get(resourceData.Id())
+	var repo repository.YumGroupRepository
+	url, err := client.Repository.GetWithURL("yum", "group", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setYumGroupRepositoryToResourceData(repo, resourceData)
+	return setYumGroupRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceYumGroupRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
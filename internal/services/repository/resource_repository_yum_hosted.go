@@ -3,24 +3,29 @@ package repository
 import (
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
 	repositorySchema "github.com/datadrivers/terraform-provider-nexus/internal/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceRepositoryYumHosted() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this resource to create a hosted yum repository.",
+		Description: `Use this resource to create a hosted yum repository.
 
-		Create: resourceYumHostedRepositoryCreate,
-		Delete: resourceYumHostedRepositoryDelete,
-		Exists: resourceYumHostedRepositoryExists,
-		Read:   resourceYumHostedRepositoryRead,
-		Update: resourceYumHostedRepositoryUpdate,
+Signing of the repository metadata can only be configured for yum group and proxy repositories, see ` + "`yum_signing`" + ` of ` + "`nexus_repository_yum_group`" + `. The Nexus API of hosted yum repositories (` + "`/v1/repositories/yum/hosted`" + `) has no signing settings.`,
+
+		CustomizeDiff: customdiff.All(validateBlobStore, validateCleanupPolicies),
+		Create:        resourceYumHostedRepositoryCreate,
+		Delete:        resourceYumHostedRepositoryDelete,
+		Exists:        resourceYumHostedRepositoryExists,
+		Read:          resourceYumHostedRepositoryRead,
+		Update:        resourceYumHostedRepositoryUpdate,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importHostedRepositoryState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -28,10 +33,12 @@ func ResourceRepositoryYumHosted() *schema.Resource {
 			"id":     common.ResourceID,
 			"name":   repositorySchema.ResourceName,
 			"online": repositorySchema.ResourceOnline,
+			"url":    repositorySchema.ResourceURL,
 			// Hosted schemas
-			"cleanup":   repositorySchema.ResourceCleanup,
-			"component": repositorySchema.ResourceComponent,
-			"storage":   repositorySchema.ResourceHostedStorage,
+			"cleanup":       repositorySchema.ResourceCleanup,
+			"component":     repositorySchema.ResourceComponent,
+			"force_destroy": repositorySchema.ResourceForceDestroy,
+			"storage":       repositorySchema.ResourceHostedStorage,
 			// Yum hosted schemas
 			"deploy_policy": {
 				Default:      "STRICT",
@@ -136,19 +143,19 @@ func resourceYumHostedRepositoryCreate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceYumHostedRepositoryRead(resourceData *schema.ResourceData, m interface{}) error {
-	client := m.(*nexus.NexusClient)
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
 
-	repo, err := client.Repository.Yum.Hosted.Get(resourceData.Id())
+	var repo repository.YumHostedRepository
+	url, err := client.Repository.GetWithURL("yum", "hosted", resourceData.Id(), &repo)
 	if err != nil {
 		return err
 	}
 
-	if repo == nil {
-		resourceData.SetId("")
-		return nil
+	if err := resourceData.Set("url", url); err != nil {
+		return err
 	}
 
-	return setYumHostedRepositoryToResourceData(repo, resourceData)
+	return setYumHostedRepositoryToResourceData(&repo, resourceData)
 }
 
 func resourceYumHostedRepositoryUpdate(resourceData *schema.ResourceData, m interface{}) error {
@@ -165,6 +172,10 @@ func resourceYumHostedRepositoryUpdate(resourceData *schema.ResourceData, m inte
 }
 
 func resourceYumHostedRepositoryDelete(resourceData *schema.ResourceData, m interface{}) error {
+	if err := validateForceDestroy(resourceData, m); err != nil {
+		return err
+	}
+
 	client := m.(*nexus.NexusClient)
 	return client.Repository.Yum.Hosted.Delete(resourceData.Id())
 }
@@ -10,19 +10,20 @@ import (
 	"github.com/datadrivers/go-nexus-client/nexus3/schema"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/repository"
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
 	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-func testAccResourceRepositoryYumProxy() repository.YumProxyRepository {
+func testAccResourceRepositoryYumProxy() nexus3.YumProxyRepository {
 	enableCircularRedirects := true
 	enableCookies := true
 	retries := 3
 	timeout := 15
 	useTrustStore := true
 
-	return repository.YumProxyRepository{
+	return nexus3.YumProxyRepository{
 		Name:   fmt.Sprintf("test-repo-%s", acctest.RandString(10)),
 		Online: true,
 		YumSigning: &repository.YumSigning{
@@ -36,10 +37,10 @@ func testAccResourceRepositoryYumProxy() repository.YumProxyRepository {
 		Cleanup: &repository.Cleanup{
 			PolicyNames: []string{"cleanup-weekly"},
 		},
-		HTTPClient: repository.HTTPClient{
+		HTTPClient: nexus3.HTTPClient{
 			AutoBlock: true,
 			Blocked:   false,
-			Authentication: &repository.HTTPClientAuthentication{
+			Authentication: &nexus3.HTTPClientAuthentication{
 				Password: "acceptance-password",
 				Type:     repository.HTTPClientAuthenticationTypeUsername,
 				Username: "acceptance-user",
@@ -65,7 +66,7 @@ func testAccResourceRepositoryYumProxy() repository.YumProxyRepository {
 	}
 }
 
-func testAccResourceRepositoryYumProxyConfig(repo repository.YumProxyRepository) string {
+func testAccResourceRepositoryYumProxyConfig(repo nexus3.YumProxyRepository) string {
 	buf := &bytes.Buffer{}
 	resourceRepositoryYumProxyTemplate := template.Must(template.New("YumProxyRepository").Funcs(acceptance.TemplateFuncMap).Parse(acceptance.TemplateStringRepositoryYumProxy))
 	if err := resourceRepositoryYumProxyTemplate.Execute(buf, repo); err != nil {
@@ -0,0 +1,66 @@
+package security
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityContentSelectors() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get a list of all content selectors.",
+
+		Read: dataSourceSecurityContentSelectorsRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"selectors": {
+				Description: "A list of all content selectors",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Content selector name",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"description": {
+							Description: "A description of the content selector",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"expression": {
+							Description: "The content selector expression",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityContentSelectorsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	contentSelectors, err := client.Security.ContentSelector.List()
+	if err != nil {
+		return err
+	}
+
+	items := []map[string]string{}
+	for _, contentSelector := range contentSelectors {
+		items = append(items, map[string]string{
+			"name":        contentSelector.Name,
+			"description": contentSelector.Description,
+			"expression":  contentSelector.Expression,
+		})
+	}
+	if err := d.Set("selectors", items); err != nil {
+		return err
+	}
+
+	d.SetId("securityContentSelectors")
+	return nil
+}
@@ -0,0 +1,46 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityContentSelectors(t *testing.T) {
+	dataSourceName := "data.nexus_security_content_selectors.acceptance"
+
+	cs := security.ContentSelector{
+		Name:        acctest.RandString(10),
+		Description: acctest.RandString(30),
+		Expression:  fmt.Sprintf("format == '%s' and path == '%s'", acctest.RandString(15), acctest.RandString(15)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityContentSelectorConfig(cs) + testAccDataSourceSecurityContentSelectorsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "selectors.*", map[string]string{
+						"name":        cs.Name,
+						"description": cs.Description,
+						"expression":  cs.Expression,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityContentSelectorsConfig() string {
+	return `
+data "nexus_security_content_selectors" "acceptance" {
+	depends_on = [nexus_security_content_selector.acceptance]
+}
+`
+}
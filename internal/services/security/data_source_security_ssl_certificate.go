@@ -0,0 +1,59 @@
+package security
+
+import (
+	"fmt"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceSecuritySSLCertificate() *schema.Resource {
+	dataSourceSchema := sslCertificateComputedSchema()
+	dataSourceSchema["id"] = common.DataSourceID
+	dataSourceSchema["host"] = &schema.Schema{
+		Description: "The host to retrieve the certificate from",
+		Type:        schema.TypeString,
+		Required:    true,
+	}
+	dataSourceSchema["port"] = &schema.Schema{
+		Default:      443,
+		Description:  "The port to retrieve the certificate from. Default: 443",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IsPortNumber,
+	}
+	dataSourceSchema["pem"] = &schema.Schema{
+		Description: "The PEM encoded certificate presented by the host",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Use this data source to retrieve the certificate presented by a remote host, as seen by Nexus.",
+
+		Read:   dataSourceSecuritySSLCertificateRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceSecuritySSLCertificateRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	request := &security.CertificateRequest{
+		Host: d.Get("host").(string),
+		Port: d.Get("port").(int),
+	}
+
+	certificate, err := client.Security.SSL.GetCertificate(request)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", request.Host, request.Port))
+	setSecuritySSLCertificateToResourceData(certificate, d)
+
+	return nil
+}
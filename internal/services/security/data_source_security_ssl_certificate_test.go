@@ -0,0 +1,38 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecuritySSLCertificate(t *testing.T) {
+	dataSourceName := "data.nexus_security_ssl_certificate.acceptance"
+	host := "repo1.maven.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecuritySSLCertificateConfig(host),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf("%s:443", host)),
+					resource.TestCheckResourceAttr(dataSourceName, "port", "443"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "pem"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "fingerprint"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subject_common_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecuritySSLCertificateConfig(host string) string {
+	return fmt.Sprintf(`
+data "nexus_security_ssl_certificate" "acceptance" {
+	host = "%s"
+}`, host)
+}
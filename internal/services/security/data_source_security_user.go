@@ -7,7 +7,7 @@ import (
 
 func DataSourceSecurityUser() *schema.Resource {
 	return &schema.Resource{
-		Description: "Use this data source to get a user data structure.",
+		Description: "Use this data source to get a user data structure. Users of all sources, e.g. LDAP, are looked up.",
 
 		Read: dataSourceSecurityUserRead,
 		Schema: map[string]*schema.Schema{
@@ -38,6 +38,11 @@ func DataSourceSecurityUser() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"source": {
+				Description: "The user source which is the origin of this user, e.g. default or LDAP.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"status": {
 				Description: "The user's status, e.g. active or disabled.",
 				Type:        schema.TypeString,
@@ -37,6 +37,7 @@ func TestAccDataSourceSecurityUser(t *testing.T) {
 					// Password is not returned by API
 					// resource.TestCheckResourceAttr(resName, "password", user.Password),
 					resource.TestCheckResourceAttr(resName, "email", user.EmailAddress),
+					resource.TestCheckResourceAttr(resName, "source", "default"),
 					resource.TestCheckResourceAttr(resName, "status", user.Status),
 					resource.TestCheckResourceAttr(resName, "roles.#", strconv.Itoa(len(user.Roles))),
 				),
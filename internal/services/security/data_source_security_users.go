@@ -0,0 +1,113 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get a list of users.",
+
+		Read: dataSourceSecurityUsersRead,
+		Schema: map[string]*schema.Schema{
+			"id": common.DataSourceID,
+			"userid": {
+				Description: "Only return users whose userid starts with this prefix",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"source": {
+				Description: "Only return users of this user source, e.g. default or LDAP. All sources are searched if not set",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"users": {
+				Description: "A list of all matching users",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"userid": {
+							Description: "The userid which is required for login",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"firstname": {
+							Description: "The first name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"lastname": {
+							Description: "The last name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "The email address associated with the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"roles": {
+							Description: "The roles which the user has been assigned within Nexus.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"source": {
+							Description: "The user source which is the origin of this user, e.g. default or LDAP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The user's status, e.g. active or disabled.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityUsersRead(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	userIDPrefix := d.Get("userid").(string)
+	source := d.Get("source").(string)
+
+	users, err := client.Security.User.List(userIDPrefix, source)
+	if err != nil {
+		return err
+	}
+
+	items := []map[string]interface{}{}
+	for _, user := range users {
+		// The API search term is not guaranteed to be a prefix match
+		if !strings.HasPrefix(user.UserID, userIDPrefix) {
+			continue
+		}
+		items = append(items, map[string]interface{}{
+			"userid":    user.UserID,
+			"firstname": user.FirstName,
+			"lastname":  user.LastName,
+			"email":     user.EmailAddress,
+			"roles":     tools.StringSliceToInterfaceSlice(user.Roles),
+			"source":    user.Source,
+			"status":    user.Status,
+		})
+	}
+	if err := d.Set("users", items); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s-%s", source, userIDPrefix))
+	return nil
+}
@@ -0,0 +1,53 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSecurityUsers(t *testing.T) {
+	resName := "data.nexus_security_users.acceptance"
+	user := security.User{
+		UserID:       fmt.Sprintf("user-test-%s", acctest.RandString(10)),
+		FirstName:    fmt.Sprintf("user-firstname-%s", acctest.RandString(10)),
+		LastName:     fmt.Sprintf("user-lastname-%s", acctest.RandString(10)),
+		EmailAddress: fmt.Sprintf("user-email-%s@example.com", acctest.RandString(10)),
+		Status:       "active",
+		Password:     acctest.RandString(16),
+		Roles:        []string{"nx-admin"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserConfig(user) + testAccDataSourceSecurityUsersConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resName, "users.0.userid", user.UserID),
+					resource.TestCheckResourceAttr(resName, "users.0.firstname", user.FirstName),
+					resource.TestCheckResourceAttr(resName, "users.0.lastname", user.LastName),
+					resource.TestCheckResourceAttr(resName, "users.0.email", user.EmailAddress),
+					resource.TestCheckResourceAttr(resName, "users.0.source", "default"),
+					resource.TestCheckResourceAttr(resName, "users.0.status", user.Status),
+					resource.TestCheckResourceAttr(resName, "users.0.roles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityUsersConfig() string {
+	return `
+data "nexus_security_users" "acceptance" {
+	userid = nexus_security_user.acceptance.userid
+	source = "default"
+}
+`
+}
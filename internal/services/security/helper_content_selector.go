@@ -0,0 +1,63 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validateContentSelectorExpression does a basic syntax check of a CSEL
+// expression: quotes and parentheses must be balanced and at least one
+// comparison (==, =~ or =^) is required. Nexus does the full validation.
+func validateContentSelectorExpression(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if strings.TrimSpace(v) == "" {
+		return nil, []error{fmt.Errorf("%s must not be empty", k)}
+	}
+
+	depth := 0
+	var quote rune
+	escaped := false
+	unquoted := strings.Builder{}
+	for _, c := range v {
+		if quote != 0 {
+			// A backslash escapes the next character within string literals
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == quote:
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'':
+			quote = c
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, []error{fmt.Errorf("%s has an unexpected closing parenthesis", k)}
+			}
+		}
+		unquoted.WriteRune(c)
+	}
+	if quote != 0 {
+		return nil, []error{fmt.Errorf("%s has an unterminated string literal", k)}
+	}
+	if depth != 0 {
+		return nil, []error{fmt.Errorf("%s has an unclosed parenthesis", k)}
+	}
+
+	operators := unquoted.String()
+	if !strings.Contains(operators, "==") && !strings.Contains(operators, "=~") && !strings.Contains(operators, "=^") {
+		return nil, []error{fmt.Errorf("%s must contain at least one comparison using ==, =~ or =^", k)}
+	}
+
+	return nil, nil
+}
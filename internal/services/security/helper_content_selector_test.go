@@ -0,0 +1,37 @@
+package security
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateContentSelectorExpression(t *testing.T) {
+	valid := []string{
+		`format == "maven2"`,
+		`format == 'raw' and path =^ '/team/'`,
+		`(format == "npm" or format == "raw") and path =~ "^/a(b|c)/.*"`,
+		`path =~ "a\"b"`,
+		`path =~ 'a\'b' and format == "raw"`,
+		`path =~ "^/a\\\\" and format == "raw"`,
+	}
+	for _, expression := range valid {
+		_, errs := validateContentSelectorExpression(expression, "expression")
+		assert.Empty(t, errs, expression)
+	}
+
+	invalid := []string{
+		"",
+		"  ",
+		`format`,
+		`format == "maven2`,
+		`path =~ "a\"`,
+		`(format == "maven2"`,
+		`format == "maven2")`,
+		`path = "/paths/with/==/inside"`,
+	}
+	for _, expression := range invalid {
+		_, errs := validateContentSelectorExpression(expression, "expression")
+		assert.NotEmpty(t, errs, expression)
+	}
+}
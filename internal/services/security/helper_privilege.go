@@ -0,0 +1,87 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	// Actions of repository privileges using BREAD syntax
+	privilegeActionsBREAD = []string{"BROWSE", "READ", "EDIT", "ADD", "DELETE", "ALL"}
+	// Actions of script privileges
+	privilegeActionsScript = []string{"BROWSE", "READ", "EDIT", "ADD", "DELETE", "RUN", "ALL"}
+	// Actions of application privileges
+	privilegeActionsApplication = []string{"BROWSE", "READ", "EDIT", "ADD", "DELETE", "RUN", "ASSOCIATE", "DISASSOCIATE", "ALL"}
+)
+
+var (
+	resourcePrivilegeFormat = &schema.Schema{
+		Description:  "The repository format the privilege applies to, e.g. `maven2`, or `*` for all formats",
+		Required:     true,
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+	resourcePrivilegeRepository = &schema.Schema{
+		Description:  "The name of the repository the privilege applies to, or `*` for all repositories of the format",
+		Required:     true,
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+)
+
+func resourcePrivilegeActionsSchema(actions []string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("Actions granted by the privilege. Possible values: `%s`", strings.Join(actions, "`, `")),
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(actions, false),
+		},
+		Required: true,
+		Type:     schema.TypeSet,
+	}
+}
+
+// getPrivilege returns the privilege of the resource and resets the id if it
+// does not exist anymore. Privilege names are unique across all types, so
+// a privilege of another type is an error, e.g. after importing the wrong name
+func getPrivilege(d *schema.ResourceData, m interface{}, privilegeType string) (*security.Privilege, error) {
+	client := m.(*nexus.NexusClient)
+
+	privilege, err := client.Security.Privilege.Get(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if privilege == nil {
+		d.SetId("")
+		return nil, nil
+	}
+	if privilege.Type != privilegeType {
+		return nil, fmt.Errorf("privilege '%s' is of type '%s', not '%s'", privilege.Name, privilege.Type, privilegeType)
+	}
+	return privilege, nil
+}
+
+func resourceSecurityPrivilegeDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	if err := client.Security.Privilege.Delete(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSecurityPrivilegeExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*nexus.NexusClient)
+
+	privilege, err := client.Security.Privilege.Get(d.Id())
+	return privilege != nil, err
+}
@@ -0,0 +1,69 @@
+package security
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/pkg/client"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPrivilege(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/service/rest/v1/security/privileges", r.URL.Path)
+		w.Write([]byte(`[{"name": "nx-script-run", "type": "script", "scriptName": "run", "actions": ["RUN"]}]`))
+	}))
+	t.Cleanup(server.Close)
+	client := nexus.NewClient(client.Config{URL: server.URL})
+	resource := ResourcePrivilegeScript()
+
+	d := resource.Data(&terraform.InstanceState{ID: "nx-script-run"})
+	privilege, err := getPrivilege(d, client, security.PrivilegeTypeScript)
+	assert.NoError(t, err)
+	assert.Equal(t, "run", privilege.ScriptName)
+
+	d = resource.Data(&terraform.InstanceState{ID: "nx-script-run"})
+	privilege, err = getPrivilege(d, client, security.PrivilegeTypeWildcard)
+	assert.EqualError(t, err, "privilege 'nx-script-run' is of type 'script', not 'wildcard'")
+	assert.Nil(t, privilege)
+
+	// Missing privileges are removed from the state
+	d = resource.Data(&terraform.InstanceState{ID: "nx-does-not-exist"})
+	privilege, err = getPrivilege(d, client, security.PrivilegeTypeScript)
+	assert.NoError(t, err)
+	assert.Nil(t, privilege)
+	assert.Empty(t, d.Id())
+}
+
+func TestPrivilegeWildcardPatternRegexp(t *testing.T) {
+	valid := []string{
+		`*`,
+		`nexus:*`,
+		`nexus:repository-view:maven2:*:browse,read`,
+		`nexus:repository-admin:*:*:add,edit,delete`,
+		`nexus:tasks:*`,
+	}
+	for _, pattern := range valid {
+		assert.True(t, privilegeWildcardPatternRegexp.MatchString(pattern), pattern)
+	}
+
+	invalid := []string{
+		``,
+		`:`,
+		`nexus:`,
+		`:nexus`,
+		`nexus::*`,
+		`nexus:repository-view:maven2,:*`,
+		`nexus:repository-view:,maven2:*`,
+		`nexus:repository view:*`,
+		` nexus:*`,
+		"nexus:*\n",
+	}
+	for _, pattern := range invalid {
+		assert.False(t, privilegeWildcardPatternRegexp.MatchString(pattern), pattern)
+	}
+}
@@ -0,0 +1,57 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateRoles makes sure all newly assigned roles exist or are created in the
+// same run, so the user gets a readable error at plan time instead of a HTTP
+// 400 from Nexus during apply.
+func validateRoles(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.HasChange("roles") || !diff.NewValueKnown("roles") {
+		return nil
+	}
+
+	oldRoles, newRoles := diff.GetChange("roles")
+	added := newRoles.(*schema.Set).Difference(oldRoles.(*schema.Set))
+	if added.Len() == 0 {
+		return nil
+	}
+
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+	roles, err := client.Security.Role.List()
+	if nexus3.HasStatusCode(err, http.StatusForbidden) {
+		// Listing roles requires additional privileges, do not block the
+		// plan if they are missing and let Nexus decide instead.
+		log.Printf("[WARN] Skipping role validation: %v", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, role := range roles {
+		existing[role.ID] = true
+	}
+
+	missing := []string{}
+	for _, role := range added.List() {
+		if !existing[role.(string)] && !tools.IsPlanned(m, tools.PlannedRole, role.(string)) {
+			missing = append(missing, role.(string))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("roles do not exist: %s. Roles created in the same run have to be referenced by their resource, e.g. nexus_security_role.example.roleid", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package security
+
+import (
+	"strings"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sslCertificateComputedSchema returns the read-only certificate details reported by nexus
+func sslCertificateComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"expires_on": {
+			Description: "The expiration date of the certificate in milliseconds since epoch",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"fingerprint": {
+			Description: "The SHA-1 fingerprint of the certificate",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"issued_on": {
+			Description: "The issue date of the certificate in milliseconds since epoch",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"issuer_common_name": {
+			Description: "The common name of the certificate issuer",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"issuer_organization": {
+			Description: "The organization of the certificate issuer",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"issuer_organization_unit": {
+			Description: "The organizational unit of the certificate issuer",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"serial_number": {
+			Description: "The serial number of the certificate",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subject_common_name": {
+			Description: "The common name of the certificate subject",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subject_organization": {
+			Description: "The organization of the certificate subject",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subject_organization_unit": {
+			Description: "The organizational unit of the certificate subject",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func setSecuritySSLCertificateToResourceData(certificate *security.SSLCertificate, d *schema.ResourceData) {
+	d.Set("expires_on", certificate.ExpiresOn)
+	d.Set("fingerprint", certificate.Fingerprint)
+	d.Set("issued_on", certificate.IssuedOn)
+	d.Set("issuer_common_name", certificate.IssuerCommonName)
+	d.Set("issuer_organization", certificate.IssuerOrganization)
+	d.Set("issuer_organization_unit", certificate.IssuerOrganizationUnit)
+	d.Set("pem", certificate.Pem)
+	d.Set("serial_number", certificate.SerialNumber)
+	d.Set("subject_common_name", certificate.SubjectCommonName)
+	d.Set("subject_organization", certificate.SubjectOrganization)
+	d.Set("subject_organization_unit", certificate.SubjectOrganizationUnit)
+}
+
+// normalizePEM strips all whitespace, nexus re-encodes the PEM with its own line breaks
+func normalizePEM(pem string) string {
+	return strings.Join(strings.Fields(pem), "")
+}
+
+func suppressEquivalentPEM(k, old, new string, d *schema.ResourceData) bool {
+	return normalizePEM(old) == normalizePEM(new)
+}
@@ -0,0 +1,58 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+)
+
+const (
+	userSourceDefault = "default"
+)
+
+// Several resources change the same user by read-modify-write requests, which
+// must not run in parallel for one user
+var securityUserMutexes sync.Map
+
+// lockSecurityUser locks the user for a read-modify-write request and returns
+// the function to unlock it again
+func lockSecurityUser(userID string, source string) func() {
+	mutex, _ := securityUserMutexes.LoadOrStore(securityUserSourceID(userID, source), &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+	return mutex.(*sync.Mutex).Unlock
+}
+
+// securityUserSourceID keeps the plain userid as id for users of the default
+// source, users of other sources are identified by <source>/<userid>
+func securityUserSourceID(userID string, source string) string {
+	if source == userSourceDefault {
+		return userID
+	}
+	return fmt.Sprintf("%s/%s", source, userID)
+}
+
+func parseSecurityUserSourceID(id string) (userID string, source string) {
+	if parts := strings.SplitN(id, "/", 2); len(parts) == 2 {
+		return parts[1], parts[0]
+	}
+	return id, userSourceDefault
+}
+
+func getSecurityUserOfSource(m interface{}, userID string, source string) (*security.User, error) {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	users, err := client.Security.User.List(userID, source)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.UserID == userID {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,94 @@
+package security
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourcePrivilegeApplication() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a Nexus application privilege.",
+
+		Create: resourcePrivilegeApplicationCreate,
+		Read:   resourcePrivilegeApplicationRead,
+		Update: resourcePrivilegeApplicationUpdate,
+		Delete: resourceSecurityPrivilegeDelete,
+		Exists: resourceSecurityPrivilegeExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"name": {
+				Description: "The name of the privilege",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"description": {
+				Description: "A description of the privilege",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"actions": resourcePrivilegeActionsSchema(privilegeActionsApplication),
+			"domain": {
+				Description:  "The domain of the privilege, e.g. `users` or `settings`",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func getPrivilegeApplicationFromResourceData(d *schema.ResourceData) security.Privilege {
+	return security.Privilege{
+		Actions:     tools.InterfaceSliceToStringSlice(d.Get("actions").(*schema.Set).List()),
+		Description: d.Get("description").(string),
+		Domain:      d.Get("domain").(string),
+		Name:        d.Get("name").(string),
+		Type:        security.PrivilegeTypeApplication,
+	}
+}
+
+func resourcePrivilegeApplicationCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	privilege := getPrivilegeApplicationFromResourceData(d)
+	if err := client.Security.Privilege.Create(privilege); err != nil {
+		return err
+	}
+
+	d.SetId(privilege.Name)
+
+	return resourcePrivilegeApplicationRead(d, m)
+}
+
+func resourcePrivilegeApplicationRead(d *schema.ResourceData, m interface{}) error {
+	privilege, err := getPrivilege(d, m, security.PrivilegeTypeApplication)
+	if err != nil || privilege == nil {
+		return err
+	}
+
+	d.Set("actions", tools.StringSliceToInterfaceSlice(privilege.Actions))
+	d.Set("description", privilege.Description)
+	d.Set("domain", privilege.Domain)
+	d.Set("name", privilege.Name)
+
+	return nil
+}
+
+func resourcePrivilegeApplicationUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	privilege := getPrivilegeApplicationFromResourceData(d)
+	if err := client.Security.Privilege.Update(d.Id(), privilege); err != nil {
+		return err
+	}
+
+	return resourcePrivilegeApplicationRead(d, m)
+}
@@ -0,0 +1,58 @@
+package security_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePrivilegeApplication(t *testing.T) {
+	resName := "nexus_privilege_application.acceptance"
+
+	privilege := security.Privilege{
+		Name:        fmt.Sprintf("privilege-%s", acctest.RandString(10)),
+		Description: acctest.RandString(30),
+		Actions:     []string{"READ", "EDIT"},
+		Domain:      security.PrivilegeDomainUsers,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePrivilegeApplicationConfig(privilege),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", privilege.Name),
+					resource.TestCheckResourceAttr(resName, "name", privilege.Name),
+					resource.TestCheckResourceAttr(resName, "description", privilege.Description),
+					resource.TestCheckResourceAttr(resName, "domain", privilege.Domain),
+					resource.TestCheckResourceAttr(resName, "actions.#", strconv.Itoa(len(privilege.Actions))),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     privilege.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourcePrivilegeApplicationConfig(privilege security.Privilege) string {
+	return fmt.Sprintf(`
+resource "nexus_privilege_application" "acceptance" {
+	name        = "%s"
+	description = "%s"
+	actions     = ["%s"]
+	domain      = "%s"
+}
+`, privilege.Name, privilege.Description, strings.Join(privilege.Actions, "\", \""), privilege.Domain)
+}
@@ -0,0 +1,91 @@
+package security
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourcePrivilegeRepositoryAdmin() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a Nexus repository admin privilege.",
+
+		Create: resourcePrivilegeRepositoryAdminCreate,
+		Read:   resourcePrivilegeRepositoryAdminRead,
+		Update: resourcePrivilegeRepositoryAdminUpdate,
+		Delete: resourceSecurityPrivilegeDelete,
+		Exists: resourceSecurityPrivilegeExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"name": {
+				Description: "The name of the privilege",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"description": {
+				Description: "A description of the privilege",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"actions":    resourcePrivilegeActionsSchema(privilegeActionsBREAD),
+			"format":     resourcePrivilegeFormat,
+			"repository": resourcePrivilegeRepository,
+		},
+	}
+}
+
+func getPrivilegeRepositoryAdminFromResourceData(d *schema.ResourceData) security.Privilege {
+	return security.Privilege{
+		Actions:     tools.InterfaceSliceToStringSlice(d.Get("actions").(*schema.Set).List()),
+		Description: d.Get("description").(string),
+		Format:      d.Get("format").(string),
+		Name:        d.Get("name").(string),
+		Repository:  d.Get("repository").(string),
+		Type:        security.PrivilegeTypeRepositoryAdmin,
+	}
+}
+
+func resourcePrivilegeRepositoryAdminCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	privilege := getPrivilegeRepositoryAdminFromResourceData(d)
+	if err := client.Security.Privilege.Create(privilege); err != nil {
+		return err
+	}
+
+	d.SetId(privilege.Name)
+
+	return resourcePrivilegeRepositoryAdminRead(d, m)
+}
+
+func resourcePrivilegeRepositoryAdminRead(d *schema.ResourceData, m interface{}) error {
+	privilege, err := getPrivilege(d, m, security.PrivilegeTypeRepositoryAdmin)
+	if err != nil || privilege == nil {
+		return err
+	}
+
+	d.Set("actions", tools.StringSliceToInterfaceSlice(privilege.Actions))
+	d.Set("description", privilege.Description)
+	d.Set("format", privilege.Format)
+	d.Set("name", privilege.Name)
+	d.Set("repository", privilege.Repository)
+
+	return nil
+}
+
+func resourcePrivilegeRepositoryAdminUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	privilege := getPrivilegeRepositoryAdminFromResourceData(d)
+	if err := client.Security.Privilege.Update(d.Id(), privilege); err != nil {
+		return err
+	}
+
+	return resourcePrivilegeRepositoryAdminRead(d, m)
+}
@@ -0,0 +1,61 @@
+package security_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePrivilegeRepositoryAdmin(t *testing.T) {
+	resName := "nexus_privilege_repository_admin.acceptance"
+
+	privilege := security.Privilege{
+		Name:        fmt.Sprintf("privilege-%s", acctest.RandString(10)),
+		Description: acctest.RandString(30),
+		Actions:     []string{"BROWSE", "READ", "EDIT"},
+		Format:      "maven2",
+		Repository:  "*",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePrivilegeRepositoryAdminConfig(privilege),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", privilege.Name),
+					resource.TestCheckResourceAttr(resName, "name", privilege.Name),
+					resource.TestCheckResourceAttr(resName, "description", privilege.Description),
+					resource.TestCheckResourceAttr(resName, "format", privilege.Format),
+					resource.TestCheckResourceAttr(resName, "repository", privilege.Repository),
+					resource.TestCheckResourceAttr(resName, "actions.#", strconv.Itoa(len(privilege.Actions))),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     privilege.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourcePrivilegeRepositoryAdminConfig(privilege security.Privilege) string {
+	return fmt.Sprintf(`
+resource "nexus_privilege_repository_admin" "acceptance" {
+	name        = "%s"
+	description = "%s"
+	actions     = ["%s"]
+	format      = "%s"
+	repository  = "%s"
+}
+`, privilege.Name, privilege.Description, strings.Join(privilege.Actions, "\", \""), privilege.Format, privilege.Repository)
+}
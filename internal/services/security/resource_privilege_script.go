@@ -0,0 +1,94 @@
+package security
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourcePrivilegeScript() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a Nexus script privilege.",
+
+		Create: resourcePrivilegeScriptCreate,
+		Read:   resourcePrivilegeScriptRead,
+		Update: resourcePrivilegeScriptUpdate,
+		Delete: resourceSecurityPrivilegeDelete,
+		Exists: resourceSecurityPrivilegeExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"name": {
+				Description: "The name of the privilege",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"description": {
+				Description: "A description of the privilege",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"actions": resourcePrivilegeActionsSchema(privilegeActionsScript),
+			"script_name": {
+				Description:  "The name of the script the privilege applies to",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func getPrivilegeScriptFromResourceData(d *schema.ResourceData) security.Privilege {
+	return security.Privilege{
+		Actions:     tools.InterfaceSliceToStringSlice(d.Get("actions").(*schema.Set).List()),
+		Description: d.Get("description").(string),
+		Name:        d.Get("name").(string),
+		ScriptName:  d.Get("script_name").(string),
+		Type:        security.PrivilegeTypeScript,
+	}
+}
+
+func resourcePrivilegeScriptCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	privilege := getPrivilegeScriptFromResourceData(d)
+	if err := client.Security.Privilege.Create(privilege); err != nil {
+		return err
+	}
+
+	d.SetId(privilege.Name)
+
+	return resourcePrivilegeScriptRead(d, m)
+}
+
+func resourcePrivilegeScriptRead(d *schema.ResourceData, m interface{}) error {
+	privilege, err := getPrivilege(d, m, security.PrivilegeTypeScript)
+	if err != nil || privilege == nil {
+		return err
+	}
+
+	d.Set("actions", tools.StringSliceToInterfaceSlice(privilege.Actions))
+	d.Set("description", privilege.Description)
+	d.Set("name", privilege.Name)
+	d.Set("script_name", privilege.ScriptName)
+
+	return nil
+}
+
+func resourcePrivilegeScriptUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	privilege := getPrivilegeScriptFromResourceData(d)
+	if err := client.Security.Privilege.Update(d.Id(), privilege); err != nil {
+		return err
+	}
+
+	return resourcePrivilegeScriptRead(d, m)
+}
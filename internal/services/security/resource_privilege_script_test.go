@@ -0,0 +1,64 @@
+package security_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePrivilegeScript(t *testing.T) {
+	resName := "nexus_privilege_script.acceptance"
+
+	privilege := security.Privilege{
+		Name:        fmt.Sprintf("privilege-%s", acctest.RandString(10)),
+		Description: acctest.RandString(30),
+		ScriptName:  fmt.Sprintf("script-%s", acctest.RandString(10)),
+		Actions:     []string{"RUN", "EDIT"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePrivilegeScriptConfig(privilege),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", privilege.Name),
+					resource.TestCheckResourceAttr(resName, "name", privilege.Name),
+					resource.TestCheckResourceAttr(resName, "description", privilege.Description),
+					resource.TestCheckResourceAttr(resName, "script_name", privilege.ScriptName),
+					resource.TestCheckResourceAttr(resName, "actions.#", strconv.Itoa(len(privilege.Actions))),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     privilege.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourcePrivilegeScriptConfig(privilege security.Privilege) string {
+	return fmt.Sprintf(`
+resource "nexus_script" "acceptance" {
+	name    = "%s"
+	content = "log.info('acceptance')"
+	type    = "groovy"
+}
+
+resource "nexus_privilege_script" "acceptance" {
+	name        = "%s"
+	description = "%s"
+	actions     = ["%s"]
+	script_name = nexus_script.acceptance.name
+}
+`, privilege.ScriptName, privilege.Name, privilege.Description, strings.Join(privilege.Actions, "\", \""))
+}
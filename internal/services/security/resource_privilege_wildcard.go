@@ -0,0 +1,95 @@
+package security
+
+import (
+	"regexp"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Shiro wildcard permission syntax as used by Nexus, e.g. nexus:repository-view:maven2:*:browse,read
+var privilegeWildcardPatternRegexp = regexp.MustCompile(`^[^\s:,]+(,[^\s:,]+)*(:[^\s:,]+(,[^\s:,]+)*)*$`)
+
+func ResourcePrivilegeWildcard() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to create a Nexus wildcard privilege.",
+
+		Create: resourcePrivilegeWildcardCreate,
+		Read:   resourcePrivilegeWildcardRead,
+		Update: resourcePrivilegeWildcardUpdate,
+		Delete: resourceSecurityPrivilegeDelete,
+		Exists: resourceSecurityPrivilegeExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"name": {
+				Description: "The name of the privilege",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"description": {
+				Description: "A description of the privilege",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"pattern": {
+				Description:  "The wildcard pattern of the privilege. Parts are separated by `:`, alternatives within a part by `,` and `*` matches anything, e.g. `nexus:repository-view:maven2:*:browse,read`",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(privilegeWildcardPatternRegexp, "must be a list of non-empty parts separated by ':', each being a ',' separated list without whitespace"),
+			},
+		},
+	}
+}
+
+func getPrivilegeWildcardFromResourceData(d *schema.ResourceData) security.Privilege {
+	return security.Privilege{
+		Description: d.Get("description").(string),
+		Name:        d.Get("name").(string),
+		Pattern:     d.Get("pattern").(string),
+		Type:        security.PrivilegeTypeWildcard,
+	}
+}
+
+func resourcePrivilegeWildcardCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	privilege := getPrivilegeWildcardFromResourceData(d)
+	if err := client.Security.Privilege.Create(privilege); err != nil {
+		return err
+	}
+
+	d.SetId(privilege.Name)
+
+	return resourcePrivilegeWildcardRead(d, m)
+}
+
+func resourcePrivilegeWildcardRead(d *schema.ResourceData, m interface{}) error {
+	privilege, err := getPrivilege(d, m, security.PrivilegeTypeWildcard)
+	if err != nil || privilege == nil {
+		return err
+	}
+
+	d.Set("description", privilege.Description)
+	d.Set("name", privilege.Name)
+	d.Set("pattern", privilege.Pattern)
+
+	return nil
+}
+
+func resourcePrivilegeWildcardUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	privilege := getPrivilegeWildcardFromResourceData(d)
+	if err := client.Security.Privilege.Update(d.Id(), privilege); err != nil {
+		return err
+	}
+
+	return resourcePrivilegeWildcardRead(d, m)
+}
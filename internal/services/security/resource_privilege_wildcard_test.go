@@ -0,0 +1,58 @@
+package security_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePrivilegeWildcard(t *testing.T) {
+	resName := "nexus_privilege_wildcard.acceptance"
+
+	privilege := security.Privilege{
+		Name:        fmt.Sprintf("privilege-%s", acctest.RandString(10)),
+		Description: acctest.RandString(30),
+		Pattern:     "nexus:repository-view:maven2:*:browse,read",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePrivilegeWildcardConfig(security.Privilege{Name: privilege.Name, Pattern: "nexus:repository view"}),
+				ExpectError: regexp.MustCompile("must be a list of non-empty parts"),
+			},
+			{
+				Config: testAccResourcePrivilegeWildcardConfig(privilege),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", privilege.Name),
+					resource.TestCheckResourceAttr(resName, "name", privilege.Name),
+					resource.TestCheckResourceAttr(resName, "description", privilege.Description),
+					resource.TestCheckResourceAttr(resName, "pattern", privilege.Pattern),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     privilege.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourcePrivilegeWildcardConfig(privilege security.Privilege) string {
+	return fmt.Sprintf(`
+resource "nexus_privilege_wildcard" "acceptance" {
+	name        = "%s"
+	description = "%s"
+	pattern     = "%s"
+}
+`, privilege.Name, privilege.Description, privilege.Pattern)
+}
@@ -0,0 +1,176 @@
+package security
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecurityAdminPassword() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to bootstrap and rotate the password of the admin user of a fresh Nexus instance.
+
+Configure the provider with the desired password and let other resources depend on this resource. On creation the password is changed from the generated initial password, read from ` + "`initial_password`" + ` or ` + "`initial_password_file`" + `, to ` + "`password`" + `. Nothing is changed if ` + "`password`" + ` is already valid.
+
+Destroying the resource only removes it from the state.`,
+
+		Create: resourceSecurityAdminPasswordCreate,
+		Read:   resourceSecurityAdminPasswordRead,
+		Update: resourceSecurityAdminPasswordUpdate,
+		Delete: resourceSecurityAdminPasswordDelete,
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"userid": {
+				Default:     "admin",
+				Description: "The userid of the admin user. Default: \"admin\"",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"password": {
+				Description: "The desired password of the admin user",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"initial_password": {
+				ConflictsWith: []string{"initial_password_file"},
+				Description:   "The initial password of the admin user",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+			},
+			"initial_password_file": {
+				ConflictsWith: []string{"initial_password"},
+				Description:   "Path to the `admin.password` file generated by Nexus in its data directory, which contains the initial password",
+				Type:          schema.TypeString,
+				Optional:      true,
+			},
+			"complete_onboarding": {
+				Default:     false,
+				Description: "Complete the onboarding wizard by saving the current anonymous access settings",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// canAuthenticate checks if the user can access the API with the given password.
+// Only rejected credentials count as not authenticated, other errors are returned.
+func canAuthenticate(nexusClient *nexus.NexusClient, userID string, password string) (*nexus.NexusClient, bool, error) {
+	client, err := nexus3.NewClientWithCredentials(nexusClient, userID, password)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ok, err := nexus3.NewClient(client).Security.User.CanAuthenticate(userID)
+	return client, ok, err
+}
+
+func getSecurityAdminInitialPassword(d *schema.ResourceData) (string, error) {
+	if password, ok := d.GetOk("initial_password"); ok {
+		return password.(string), nil
+	}
+
+	path, ok := d.GetOk("initial_password_file")
+	if !ok {
+		return "", fmt.Errorf("neither initial_password nor initial_password_file is set")
+	}
+	data, err := os.ReadFile(path.(string))
+	if err != nil {
+		return "", fmt.Errorf("could not read initial password: %v", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// changeSecurityAdminPassword authenticates with the current password to set the new one
+func changeSecurityAdminPassword(nexusClient *nexus.NexusClient, userID string, currentPassword string, newPassword string) error {
+	client, ok, err := canAuthenticate(nexusClient, userID, currentPassword)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("could not authenticate user '%s' with the current password", userID)
+	}
+
+	return client.Security.User.ChangePassword(userID, newPassword)
+}
+
+func completeOnboarding(nexusClient *nexus.NexusClient, userID string, password string) error {
+	client, err := nexus3.NewClientWithCredentials(nexusClient, userID, password)
+	if err != nil {
+		return err
+	}
+
+	anonymous, err := client.Security.Anonymous.Read()
+	if err != nil {
+		return err
+	}
+	return client.Security.Anonymous.Update(*anonymous)
+}
+
+func resourceSecurityAdminPasswordCreate(d *schema.ResourceData, m interface{}) error {
+	nexusClient := m.(*nexus.NexusClient)
+	userID := d.Get("userid").(string)
+	password := d.Get("password").(string)
+
+	_, ok, err := canAuthenticate(nexusClient, userID, password)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		initialPassword, err := getSecurityAdminInitialPassword(d)
+		if err != nil {
+			return err
+		}
+		if err := changeSecurityAdminPassword(nexusClient, userID, initialPassword, password); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("complete_onboarding").(bool) {
+		if err := completeOnboarding(nexusClient, userID, password); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(userID)
+	return resourceSecurityAdminPasswordRead(d, m)
+}
+
+func resourceSecurityAdminPasswordRead(d *schema.ResourceData, m interface{}) error {
+	// The password cannot be read, the state is kept as it is
+	return nil
+}
+
+func resourceSecurityAdminPasswordUpdate(d *schema.ResourceData, m interface{}) error {
+	nexusClient := m.(*nexus.NexusClient)
+	userID := d.Id()
+
+	if d.HasChange("password") {
+		oldPassword, newPassword := d.GetChange("password")
+		if err := changeSecurityAdminPassword(nexusClient, userID, oldPassword.(string), newPassword.(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("complete_onboarding") && d.Get("complete_onboarding").(bool) {
+		if err := completeOnboarding(nexusClient, userID, d.Get("password").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceSecurityAdminPasswordRead(d, m)
+}
+
+func resourceSecurityAdminPasswordDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
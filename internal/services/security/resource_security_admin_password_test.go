@@ -0,0 +1,50 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSecurityAdminPassword(t *testing.T) {
+	resName := "nexus_security_admin_password.acceptance"
+
+	// A dedicated admin user keeps the credentials of the provider untouched
+	user := testAccResourceSecurityUser()
+	password := acctest.RandString(16)
+	rotatedPassword := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserConfig(user) + testAccResourceSecurityAdminPasswordConfig(user.Password, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+					resource.TestCheckResourceAttr(resName, "userid", user.UserID),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserConfig(user) + testAccResourceSecurityAdminPasswordConfig(user.Password, rotatedPassword),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityAdminPasswordConfig(initialPassword string, password string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_admin_password" "acceptance" {
+	userid              = nexus_security_user.acceptance.userid
+	initial_password    = "%s"
+	password            = "%s"
+	complete_onboarding = true
+}
+`, initialPassword, password)
+}
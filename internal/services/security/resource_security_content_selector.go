@@ -33,9 +33,10 @@ func ResourceSecurityContentSelector() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			"expression": {
-				Description: "The content selector expression",
-				Required:    true,
-				Type:        schema.TypeString,
+				Description:  "The content selector expression",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validateContentSelectorExpression,
 			},
 		},
 	}
@@ -0,0 +1,110 @@
+package security
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSecurityCrowd() *schema.Resource {
+	return &schema.Resource{
+		Description: `~> PRO Feature
+
+Use this resource to manage the Atlassian Crowd connection settings.
+
+-> Activate the ` + "`Crowd`" + ` realm with ` + "`nexus_security_realm_activation`" + ` to authenticate Crowd users.
+
+~> Nexus has no API to remove the Crowd settings. Destroying the resource only removes it from the state, the settings are kept.`,
+
+		Create: resourceSecurityCrowdUpdate,
+		Read:   resourceSecurityCrowdRead,
+		Update: resourceSecurityCrowdUpdate,
+		Delete: resourceSecurityCrowdDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"application_name": {
+				Description: "The name of the application configured in Crowd.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"application_password": {
+				Description: "The password of the application configured in Crowd. The password is not returned by the API.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"server_url": {
+				Description:  "The URL of the Crowd server.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"timeout": {
+				Computed:     true,
+				Description:  "The connection and socket timeout in seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"use_trust_store": {
+				Default:     false,
+				Description: "Use certificates stored in the Nexus truststore to connect to the Crowd server.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceSecurityCrowdRead(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	crowd, err := client.Security.Crowd.Get()
+	if err != nil {
+		return err
+	}
+
+	d.SetId("crowd")
+	d.Set("application_name", crowd.ApplicationName)
+	d.Set("server_url", crowd.ServerURL)
+	d.Set("timeout", crowd.Timeout)
+	d.Set("use_trust_store", crowd.UseTrustStoreForURL)
+
+	return nil
+}
+
+func resourceSecurityCrowdUpdate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	// The realm is managed by the realm resources, keep its current state
+	crowd, err := client.Security.Crowd.Get()
+	if err != nil {
+		return err
+	}
+
+	crowd.ApplicationName = d.Get("application_name").(string)
+	crowd.ApplicationPassword = d.Get("application_password").(string)
+	crowd.ServerURL = d.Get("server_url").(string)
+	crowd.UseTrustStoreForURL = d.Get("use_trust_store").(bool)
+	if timeout, ok := d.GetOk("timeout"); ok {
+		crowd.Timeout = timeout.(int)
+	}
+
+	if err := client.Security.Crowd.Update(*crowd); err != nil {
+		return err
+	}
+
+	return resourceSecurityCrowdRead(d, m)
+}
+
+func resourceSecurityCrowdDelete(d *schema.ResourceData, m interface{}) error {
+	// The settings cannot be removed, they are just no longer managed
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,56 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSecurityCrowd(t *testing.T) {
+	if tools.GetEnv("SKIP_PRO_TESTS", "false") == "true" {
+		t.Skip("Skipping Nexus Pro tests")
+	}
+
+	resName := "nexus_security_crowd.acceptance"
+	applicationName := fmt.Sprintf("acceptance-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityCrowdConfig(applicationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "crowd"),
+					resource.TestCheckResourceAttr(resName, "application_name", applicationName),
+					resource.TestCheckResourceAttr(resName, "server_url", "https://crowd.example.com/crowd"),
+					resource.TestCheckResourceAttr(resName, "timeout", "30"),
+					resource.TestCheckResourceAttr(resName, "use_trust_store", "false"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     "crowd",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Password is not returned
+				ImportStateVerifyIgnore: []string{"application_password"},
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityCrowdConfig(applicationName string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_crowd" "acceptance" {
+	application_name     = "%s"
+	application_password = "%s"
+	server_url           = "https://crowd.example.com/crowd"
+	timeout              = 30
+}
+`, applicationName, acctest.RandString(16))
+}
@@ -0,0 +1,131 @@
+package security
+
+import (
+	"fmt"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSecurityDefaultRole() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to manage the Default Role capability, which grants a role to every authenticated user, e.g. to all LDAP users.
+
+-> Activate the ` + "`DefaultRole`" + ` realm with ` + "`nexus_security_realm_activation`" + ` to grant the role.
+
+~> Nexus has no REST API for capabilities. The resource uses the Ext.Direct API of the Nexus UI (` + "`/service/extdirect`" + `), which is not part of the public API and may change with Nexus versions.`,
+
+		Create: resourceSecurityDefaultRoleCreate,
+		Read:   resourceSecurityDefaultRoleRead,
+		Update: resourceSecurityDefaultRoleUpdate,
+		Delete: resourceSecurityDefaultRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"enabled": {
+				Default:     true,
+				Description: "Whether the capability is enabled",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"notes": {
+				Default:     "",
+				Description: "Notes of the capability",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"role": {
+				Description:  "The id of the role granted to every authenticated user",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func getDefaultRoleCapabilityFromResourceData(d *schema.ResourceData) nexus3.Capability {
+	return nexus3.Capability{
+		ID:      d.Id(),
+		TypeID:  nexus3.CapabilityTypeDefaultRole,
+		Notes:   d.Get("notes").(string),
+		Enabled: d.Get("enabled").(bool),
+		Properties: map[string]string{
+			"role": d.Get("role").(string),
+		},
+	}
+}
+
+func resourceSecurityDefaultRoleCreate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	// A second Default Role capability would compete with the existing one,
+	// which has to be imported instead
+	capabilities, err := client.Capability.List()
+	if err != nil {
+		return err
+	}
+	for _, capability := range capabilities {
+		if capability.TypeID == nexus3.CapabilityTypeDefaultRole {
+			return fmt.Errorf("a default role capability already exists, import it with the id '%s'", capability.ID)
+		}
+	}
+
+	id, err := client.Capability.Create(getDefaultRoleCapabilityFromResourceData(d))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return resourceSecurityDefaultRoleRead(d, m)
+}
+
+func resourceSecurityDefaultRoleRead(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	capability, err := client.Capability.Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if capability == nil {
+		d.SetId("")
+		return nil
+	}
+	if capability.TypeID != nexus3.CapabilityTypeDefaultRole {
+		return fmt.Errorf("capability '%s' is of type '%s', not '%s'", capability.ID, capability.TypeID, nexus3.CapabilityTypeDefaultRole)
+	}
+
+	d.Set("enabled", capability.Enabled)
+	d.Set("notes", capability.Notes)
+	d.Set("role", capability.Properties["role"])
+
+	return nil
+}
+
+func resourceSecurityDefaultRoleUpdate(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	if err := client.Capability.Update(getDefaultRoleCapabilityFromResourceData(d)); err != nil {
+		return err
+	}
+
+	return resourceSecurityDefaultRoleRead(d, m)
+}
+
+func resourceSecurityDefaultRoleDelete(d *schema.ResourceData, m interface{}) error {
+	client := nexus3.NewClient(m.(*nexus.NexusClient))
+
+	if err := client.Capability.Delete(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,57 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSecurityDefaultRole(t *testing.T) {
+	resName := "nexus_security_default_role.acceptance"
+	roleID := fmt.Sprintf("acceptance-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityDefaultRoleConfig(roleID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttr(resName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "notes", "acceptance test"),
+					resource.TestCheckResourceAttr(resName, "role", roleID),
+				),
+			},
+			{
+				Config: testAccResourceSecurityDefaultRoleConfig(roleID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityDefaultRoleConfig(roleID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "nexus_security_role" "acceptance" {
+	roleid = "%[1]s"
+	name   = "%[1]s"
+}
+
+resource "nexus_security_default_role" "acceptance" {
+	enabled = %[2]t
+	notes   = "acceptance test"
+	role    = nexus_security_role.acceptance.roleid
+}
+`, roleID, enabled)
+}
@@ -0,0 +1,212 @@
+package security
+
+import (
+	"fmt"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// externalRoleMappingRealms maps the supported user sources to the realm
+// which has to be active to authenticate their users
+var externalRoleMappingRealms = map[string]string{
+	"Crowd": "Crowd",
+	"LDAP":  "LdapRealm",
+	"SAML":  "SamlRealm",
+}
+
+func ResourceSecurityExternalRoleMapping() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to map a group of an external user source to Nexus roles and privileges.
+
+The mapping is a Nexus role whose id equals the name of the external group. Users of the source which are members of the group are granted the role on login.
+
+~> The realm of the source must be active, use ` + "`nexus_security_realm_activation`" + ` and ` + "`depends_on`" + ` to activate it in the same run.`,
+
+		CustomizeDiff: tools.RegisterPlannedDiff(tools.PlannedRole, "external_group"),
+		Create:        resourceSecurityExternalRoleMappingCreate,
+		Read:          resourceSecurityExternalRoleMappingRead,
+		Update:        resourceSecurityExternalRoleMappingUpdate,
+		Delete:        resourceSecurityExternalRoleMappingDelete,
+		Exists:        resourceSecurityExternalRoleMappingExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"source": {
+				Description:  "The external user source of the group. Possible values: `Crowd`, `LDAP` or `SAML`",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Crowd", "LDAP", "SAML"}, false),
+			},
+			"external_group": {
+				Description: "The name of the external group, which is used as id of the role",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"name": {
+				Computed:    true,
+				Description: "The name of the role. Defaults to the name of the external group",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"description": {
+				Description: "The description of the role",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"privileges": {
+				Description: "The privileges granted to members of the group",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Set: func(v interface{}) int {
+					return schema.HashString(strings.ToLower(v.(string)))
+				},
+				Type: schema.TypeSet,
+			},
+			"roles": {
+				Description: "The roles granted to members of the group",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				Set: func(v interface{}) int {
+					return schema.HashString(strings.ToLower(v.(string)))
+				},
+				Type: schema.TypeSet,
+			},
+		},
+	}
+}
+
+func parseSecurityExternalRoleMappingID(id string) (source string, externalGroup string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid external role mapping id '%s', expected <source>/<external_group>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func getSecurityExternalRoleMappingFromResourceData(d *schema.ResourceData) security.Role {
+	name := d.Get("name").(string)
+	if name == "" {
+		name = d.Get("external_group").(string)
+	}
+
+	return security.Role{
+		ID:          d.Get("external_group").(string),
+		Name:        name,
+		Description: d.Get("description").(string),
+		Privileges:  tools.InterfaceSliceToStringSlice(d.Get("privileges").(*schema.Set).List()),
+		Roles:       tools.InterfaceSliceToStringSlice(d.Get("roles").(*schema.Set).List()),
+	}
+}
+
+// validateExternalRoleMappingRealm makes sure users of the source can log in at all
+func validateExternalRoleMappingRealm(client *nexus.NexusClient, source string) error {
+	realm := externalRoleMappingRealms[source]
+
+	activeRealms, err := client.Security.Realm.ListActive()
+	if err != nil {
+		return err
+	}
+	if !containsRealm(activeRealms, realm) {
+		return fmt.Errorf("realm '%s' of source '%s' is not active", realm, source)
+	}
+	return nil
+}
+
+func resourceSecurityExternalRoleMappingCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	source := d.Get("source").(string)
+
+	if err := validateExternalRoleMappingRealm(client, source); err != nil {
+		return err
+	}
+
+	role := getSecurityExternalRoleMappingFromResourceData(d)
+	if err := client.Security.Role.Create(role); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", source, role.ID))
+	return resourceSecurityExternalRoleMappingRead(d, m)
+}
+
+func resourceSecurityExternalRoleMappingRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	source, externalGroup, err := parseSecurityExternalRoleMappingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	role, err := client.Security.Role.Get(externalGroup)
+	if err != nil {
+		return err
+	}
+
+	if role == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", role.Description)
+	d.Set("external_group", role.ID)
+	d.Set("name", role.Name)
+	d.Set("privileges", tools.StringSliceToInterfaceSlice(role.Privileges))
+	d.Set("roles", tools.StringSliceToInterfaceSlice(role.Roles))
+	d.Set("source", source)
+
+	return nil
+}
+
+func resourceSecurityExternalRoleMappingUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	// The realm may have been deactivated since the mapping was created
+	if err := validateExternalRoleMappingRealm(client, d.Get("source").(string)); err != nil {
+		return err
+	}
+
+	role := getSecurityExternalRoleMappingFromResourceData(d)
+	if err := client.Security.Role.Update(role.ID, role); err != nil {
+		return err
+	}
+
+	return resourceSecurityExternalRoleMappingRead(d, m)
+}
+
+func resourceSecurityExternalRoleMappingDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	if err := client.Security.Role.Delete(d.Get("external_group").(string)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSecurityExternalRoleMappingExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*nexus.NexusClient)
+
+	_, externalGroup, err := parseSecurityExternalRoleMappingID(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	role, err := client.Security.Role.Get(externalGroup)
+	return role != nil, err
+}
@@ -0,0 +1,75 @@
+package security_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceSecurityExternalRoleMappingConfig(externalGroup string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_realm_activation" "acceptance" {
+	realm = "LdapRealm"
+}
+
+resource "nexus_security_external_role_mapping" "acceptance" {
+	source         = "LDAP"
+	external_group = "%s"
+	description    = "Developers of the LDAP directory"
+	roles          = ["nx-anonymous"]
+
+	depends_on = [nexus_security_realm_activation.acceptance]
+}
+`, externalGroup)
+}
+
+func TestAccResourceSecurityExternalRoleMapping(t *testing.T) {
+	resName := "nexus_security_external_role_mapping.acceptance"
+	externalGroup := fmt.Sprintf("acceptance-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityExternalRoleMappingConfig(externalGroup),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", fmt.Sprintf("LDAP/%s", externalGroup)),
+					resource.TestCheckResourceAttr(resName, "source", "LDAP"),
+					resource.TestCheckResourceAttr(resName, "external_group", externalGroup),
+					resource.TestCheckResourceAttr(resName, "name", externalGroup),
+					resource.TestCheckResourceAttr(resName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resName, "privileges.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityExternalRoleMappingInactiveRealm(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "nexus_security_external_role_mapping" "acceptance" {
+	source         = "Crowd"
+	external_group = "acceptance-%s"
+	roles          = ["nx-anonymous"]
+}
+`, acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("realm 'Crowd' of source 'Crowd' is not active"),
+			},
+		},
+	})
+}
@@ -0,0 +1,158 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The active realms are changed by read-modify-write requests, which must
+// not run in parallel
+var realmActivationMutex sync.Mutex
+
+// Deactivating the local authenticating realm locks out all local users,
+// including the admin user of the provider
+const nexusAuthenticatingRealm = "NexusAuthenticatingRealm"
+
+func ResourceSecurityRealmActivation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to activate a single Nexus Security realm.
+
+The realm is appended to the active realms, other realms are left untouched. Destroying the resource deactivates the realm only if it was activated by this resource, see ` + "`deactivate_on_destroy`" + `. The ` + "`NexusAuthenticatingRealm`" + ` is never deactivated.
+
+~> Do not combine this resource with ` + "`nexus_security_realms`" + `, which owns the complete list of active realms.`,
+
+		Create: resourceSecurityRealmActivationCreate,
+		Read:   resourceSecurityRealmActivationRead,
+		Delete: resourceSecurityRealmActivationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecurityRealmActivationState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"deactivate_on_destroy": {
+				Description: "Whether destroying the resource deactivates the realm. Only `true` if the realm was not active before the resource was created",
+				Computed:    true,
+				Type:        schema.TypeBool,
+			},
+			"realm": {
+				Description: "The id of the realm to activate, e.g. `DockerToken` or `LdapRealm`",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceSecurityRealmActivationCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	realmID := d.Get("realm").(string)
+
+	realmActivationMutex.Lock()
+	defer realmActivationMutex.Unlock()
+
+	availableRealms, err := client.Security.Realm.ListAvailable()
+	if err != nil {
+		return err
+	}
+	available := false
+	for _, realm := range availableRealms {
+		if realm.ID == realmID {
+			available = true
+			break
+		}
+	}
+	if !available {
+		return fmt.Errorf("realm '%s' is not available", realmID)
+	}
+
+	activeRealms, err := client.Security.Realm.ListActive()
+	if err != nil {
+		return err
+	}
+	activated := false
+	if !containsRealm(activeRealms, realmID) {
+		if err := client.Security.Realm.Activate(append(activeRealms, realmID)); err != nil {
+			return err
+		}
+		activated = true
+	}
+
+	d.SetId(realmID)
+	return d.Set("deactivate_on_destroy", activated && realmID != nexusAuthenticatingRealm)
+}
+
+func resourceSecurityRealmActivationRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	activeRealms, err := client.Security.Realm.ListActive()
+	if err != nil {
+		return err
+	}
+
+	if !containsRealm(activeRealms, d.Id()) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("realm", d.Id())
+
+	return nil
+}
+
+func resourceSecurityRealmActivationDelete(d *schema.ResourceData, m interface{}) error {
+	// Realms which were already active are left as they were found
+	if !d.Get("deactivate_on_destroy").(bool) || d.Id() == nexusAuthenticatingRealm {
+		d.SetId("")
+		return nil
+	}
+
+	client := m.(*nexus.NexusClient)
+
+	realmActivationMutex.Lock()
+	defer realmActivationMutex.Unlock()
+
+	activeRealms, err := client.Security.Realm.ListActive()
+	if err != nil {
+		return err
+	}
+
+	newActiveRealms := []string{}
+	for _, realmID := range activeRealms {
+		if realmID != d.Id() {
+			newActiveRealms = append(newActiveRealms, realmID)
+		}
+	}
+	if len(newActiveRealms) != len(activeRealms) {
+		if err := client.Security.Realm.Activate(newActiveRealms); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// importSecurityRealmActivationState keeps imported realms active on
+// destroy, because they were not activated by the resource
+func importSecurityRealmActivationState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("deactivate_on_destroy", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func containsRealm(realmIDs []string, realmID string) bool {
+	for _, id := range realmIDs {
+		if id == realmID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSecurityRealmActivation(t *testing.T) {
+	resName := "nexus_security_realm_activation.acceptance"
+	realm := "DockerToken"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityRealmActivationConfig(realm),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", realm),
+					resource.TestCheckResourceAttr(resName, "realm", realm),
+					resource.TestCheckResourceAttrSet(resName, "deactivate_on_destroy"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported realms are never deactivated on destroy
+				ImportStateVerifyIgnore: []string{"deactivate_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityRealmActivationAlreadyActive(t *testing.T) {
+	resName := "nexus_security_realm_activation.acceptance"
+	realm := "NexusAuthenticatingRealm"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityRealmActivationConfig(realm),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", realm),
+					resource.TestCheckResourceAttr(resName, "deactivate_on_destroy", "false"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckSecurityRealmActive(realm),
+	})
+}
+
+func testAccCheckSecurityRealmActive(realm string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.TestAccProvider.Meta().(*nexus.NexusClient)
+		activeRealms, err := client.Security.Realm.ListActive()
+		if err != nil {
+			return err
+		}
+		for _, realmID := range activeRealms {
+			if realmID == realm {
+				return nil
+			}
+		}
+		return fmt.Errorf("realm '%s' was deactivated", realm)
+	}
+}
+
+func testAccResourceSecurityRealmActivationConfig(realm string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_realm_activation" "acceptance" {
+	realm = "%s"
+}`, realm)
+}
@@ -14,11 +14,12 @@ func ResourceSecurityRole() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to create a Nexus Role.",
 
-		Create: resourceSecurityRoleCreate,
-		Read:   resourceSecurityRoleRead,
-		Update: resourceSecurityRoleUpdate,
-		Delete: resourceSecurityRoleDelete,
-		Exists: resourceSecurityRoleExists,
+		CustomizeDiff: tools.RegisterPlannedDiff(tools.PlannedRole, "roleid"),
+		Create:        resourceSecurityRoleCreate,
+		Read:          resourceSecurityRoleRead,
+		Update:        resourceSecurityRoleUpdate,
+		Delete:        resourceSecurityRoleDelete,
+		Exists:        resourceSecurityRoleExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
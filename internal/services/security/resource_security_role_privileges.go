@@ -0,0 +1,179 @@
+package security
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Privileges of the same role are changed by read-modify-write requests, which
+// must not run in parallel
+var rolePrivilegesMutex sync.Mutex
+
+func ResourceSecurityRolePrivileges() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to add privileges to an existing role.
+
+Only the given privileges are managed, other privileges of the role are left untouched, so multiple modules can contribute privileges to a shared role.
+
+~> The role must not be managed with ` + "`privileges`" + ` of a ` + "`nexus_security_role`" + ` resource at the same time, otherwise both resources remove the privileges of each other.`,
+
+		Create: resourceSecurityRolePrivilegesCreate,
+		Read:   resourceSecurityRolePrivilegesRead,
+		Update: resourceSecurityRolePrivilegesUpdate,
+		Delete: resourceSecurityRolePrivilegesDelete,
+		Exists: resourceSecurityRolePrivilegesExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSecurityRolePrivilegesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"roleid": {
+				Description: "The id of the role",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"privileges": {
+				Description: "The privileges which are added to the role",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+				Set: func(v interface{}) int {
+					return schema.HashString(strings.ToLower(v.(string)))
+				},
+				Type: schema.TypeSet,
+			},
+		},
+	}
+}
+
+func updateSecurityRolePrivileges(client *nexus.NexusClient, roleID string, add []string, remove []string) error {
+	rolePrivilegesMutex.Lock()
+	defer rolePrivilegesMutex.Unlock()
+
+	role, err := client.Security.Role.Get(roleID)
+	if err != nil {
+		return err
+	}
+
+	// Privilege names are case-insensitive in Nexus
+	removed := map[string]bool{}
+	for _, privilege := range remove {
+		removed[strings.ToLower(privilege)] = true
+	}
+
+	newPrivileges := []string{}
+	existing := map[string]bool{}
+	for _, privilege := range role.Privileges {
+		if !removed[strings.ToLower(privilege)] {
+			newPrivileges = append(newPrivileges, privilege)
+			existing[strings.ToLower(privilege)] = true
+		}
+	}
+	for _, privilege := range add {
+		if !existing[strings.ToLower(privilege)] {
+			newPrivileges = append(newPrivileges, privilege)
+			existing[strings.ToLower(privilege)] = true
+		}
+	}
+
+	role.Privileges = newPrivileges
+	return client.Security.Role.Update(roleID, *role)
+}
+
+func resourceSecurityRolePrivilegesCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	roleID := d.Get("roleid").(string)
+	privileges := tools.InterfaceSliceToStringSlice(d.Get("privileges").(*schema.Set).List())
+
+	if err := updateSecurityRolePrivileges(client, roleID, privileges, nil); err != nil {
+		return err
+	}
+
+	d.SetId(roleID)
+	return resourceSecurityRolePrivilegesRead(d, m)
+}
+
+func resourceSecurityRolePrivilegesRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	role, err := client.Security.Role.Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if role == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// Only report managed privileges
+	managed := d.Get("privileges").(*schema.Set)
+	foundPrivileges := []string{}
+	for _, privilege := range role.Privileges {
+		if managed.Contains(privilege) {
+			foundPrivileges = append(foundPrivileges, privilege)
+		}
+	}
+
+	d.Set("roleid", role.ID)
+	d.Set("privileges", tools.StringSliceToInterfaceSlice(foundPrivileges))
+
+	return nil
+}
+
+func resourceSecurityRolePrivilegesUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	if d.HasChange("privileges") {
+		oldPrivileges, newPrivileges := d.GetChange("privileges")
+		add := newPrivileges.(*schema.Set).Difference(oldPrivileges.(*schema.Set))
+		remove := oldPrivileges.(*schema.Set).Difference(newPrivileges.(*schema.Set))
+
+		if err := updateSecurityRolePrivileges(client, d.Id(), tools.InterfaceSliceToStringSlice(add.List()), tools.InterfaceSliceToStringSlice(remove.List())); err != nil {
+			return err
+		}
+	}
+
+	return resourceSecurityRolePrivilegesRead(d, m)
+}
+
+func resourceSecurityRolePrivilegesDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	privileges := tools.InterfaceSliceToStringSlice(d.Get("privileges").(*schema.Set).List())
+
+	if err := updateSecurityRolePrivileges(client, d.Id(), nil, privileges); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceSecurityRolePrivilegesImport adopts all current privileges of the role
+func resourceSecurityRolePrivilegesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*nexus.NexusClient)
+
+	role, err := client.Security.Role.Get(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("privileges", tools.StringSliceToInterfaceSlice(role.Privileges))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSecurityRolePrivilegesExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*nexus.NexusClient)
+
+	role, err := client.Security.Role.Get(d.Id())
+	return role != nil, err
+}
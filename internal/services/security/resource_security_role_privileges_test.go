@@ -0,0 +1,71 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccResourceSecurityRolePrivilegesConfig(roleID string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_role" "acceptance" {
+	roleid     = "%s"
+	name       = "%s"
+	privileges = ["nx-healthcheck-read"]
+
+	# Privileges are contributed by nexus_security_role_privileges
+	lifecycle {
+		ignore_changes = [privileges]
+	}
+}
+
+resource "nexus_security_role_privileges" "acceptance" {
+	roleid     = nexus_security_role.acceptance.roleid
+	privileges = ["nx-search-read"]
+}
+
+data "nexus_security_role" "acceptance" {
+	roleid = nexus_security_role_privileges.acceptance.roleid
+}
+`, roleID, roleID)
+}
+
+func TestAccResourceSecurityRolePrivileges(t *testing.T) {
+	resName := "nexus_security_role_privileges.acceptance"
+	dataSourceName := "data.nexus_security_role.acceptance"
+	roleID := fmt.Sprintf("acceptance-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityRolePrivilegesConfig(roleID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", roleID),
+					resource.TestCheckResourceAttr(resName, "roleid", roleID),
+					resource.TestCheckResourceAttr(resName, "privileges.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "privileges.*", "nx-search-read"),
+					// The privilege assigned outside of the resource is kept
+					resource.TestCheckResourceAttr(dataSourceName, "privileges.#", "2"),
+				),
+			},
+			{
+				// Import adopts all privileges of the role
+				ResourceName:  resName,
+				ImportStateId: roleID,
+				ImportState:   true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if privilegeCount := states[0].Attributes["privileges.#"]; privilegeCount != "2" {
+						return fmt.Errorf("expected 2 imported privileges, got %s", privilegeCount)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
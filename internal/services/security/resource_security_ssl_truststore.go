@@ -0,0 +1,113 @@
+package security
+
+import (
+	"fmt"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceSecuritySSLTruststore() *schema.Resource {
+	resourceSchema := sslCertificateComputedSchema()
+	resourceSchema["id"] = common.ResourceID
+	resourceSchema["pem"] = &schema.Schema{
+		Description:      "The PEM encoded certificate to add to the truststore",
+		DiffSuppressFunc: suppressEquivalentPEM,
+		ForceNew:         true,
+		Type:             schema.TypeString,
+		Required:         true,
+	}
+
+	return &schema.Resource{
+		Description: "Use this resource to add a certificate to the Nexus truststore.",
+
+		Create: resourceSecuritySSLTruststoreCreate,
+		Read:   resourceSecuritySSLTruststoreRead,
+		Delete: resourceSecuritySSLTruststoreDelete,
+		Exists: resourceSecuritySSLTruststoreExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func getSecuritySSLTruststoreCertificate(client *nexus.NexusClient, match func(security.SSLCertificate) bool) (*security.SSLCertificate, error) {
+	certificates, err := client.Security.SSL.ListCertificates()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, certificate := range *certificates {
+		if match(certificate) {
+			return &certificate, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceSecuritySSLTruststoreCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	pem := d.Get("pem").(string)
+
+	if err := client.Security.SSL.AddCertificate(&security.SSLCertificate{Pem: pem}); err != nil {
+		return err
+	}
+
+	// The API does not return the id of the added certificate
+	certificate, err := getSecuritySSLTruststoreCertificate(client, func(c security.SSLCertificate) bool {
+		return normalizePEM(c.Pem) == normalizePEM(pem)
+	})
+	if err != nil {
+		return err
+	}
+	if certificate == nil {
+		return fmt.Errorf("could not find added certificate in truststore")
+	}
+
+	d.SetId(certificate.Id)
+	return resourceSecuritySSLTruststoreRead(d, m)
+}
+
+func resourceSecuritySSLTruststoreRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	certificate, err := getSecuritySSLTruststoreCertificate(client, func(c security.SSLCertificate) bool {
+		return c.Id == d.Id()
+	})
+	if err != nil {
+		return err
+	}
+
+	if certificate == nil {
+		d.SetId("")
+		return nil
+	}
+
+	setSecuritySSLCertificateToResourceData(certificate, d)
+	return nil
+}
+
+func resourceSecuritySSLTruststoreDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	if err := client.Security.SSL.RemoveCertificate(d.Id()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSecuritySSLTruststoreExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client := m.(*nexus.NexusClient)
+
+	certificate, err := getSecuritySSLTruststoreCertificate(client, func(c security.SSLCertificate) bool {
+		return c.Id == d.Id()
+	})
+	return certificate != nil, err
+}
@@ -0,0 +1,77 @@
+package security_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccSelfSignedCertificatePEM(t *testing.T, commonName string) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject: pkix.Name{
+			CommonName:   commonName,
+			Organization: []string{"acceptance"},
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestAccResourceSecuritySSLTruststore(t *testing.T) {
+	resName := "nexus_security_ssl_truststore.acceptance"
+	commonName := fmt.Sprintf("acceptance-%s.example.com", acctest.RandString(10))
+	certificate := testAccSelfSignedCertificatePEM(t, commonName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecuritySSLTruststoreConfig(certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "id"),
+					resource.TestCheckResourceAttrSet(resName, "fingerprint"),
+					resource.TestCheckResourceAttr(resName, "subject_common_name", commonName),
+					resource.TestCheckResourceAttr(resName, "subject_organization", "acceptance"),
+					resource.TestCheckResourceAttr(resName, "issuer_common_name", commonName),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSecuritySSLTruststoreConfig(certificate string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_ssl_truststore" "acceptance" {
+	pem = <<EOT
+%sEOT
+}`, certificate)
+}
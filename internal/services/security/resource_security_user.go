@@ -13,11 +13,12 @@ func ResourceSecurityUser() *schema.Resource {
 	return &schema.Resource{
 		Description: "Use this resource to manage users.",
 
-		Create: resourceSecurityUserCreate,
-		Read:   resourceSecurityUserRead,
-		Update: resourceSecurityUserUpdate,
-		Delete: resourceSecurityUserDelete,
-		Exists: resourceSecurityUserExists,
+		CustomizeDiff: validateRoles,
+		Create:        resourceSecurityUserCreate,
+		Read:          resourceSecurityUserRead,
+		Update:        resourceSecurityUserUpdate,
+		Delete:        resourceSecurityUserDelete,
+		Exists:        resourceSecurityUserExists,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -46,10 +47,25 @@ func ResourceSecurityUser() *schema.Resource {
 				Required:    true,
 			},
 			"password": {
-				Description: "The password for the user.",
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Description:  "The password for the user. Exactly one of `password` or `password_wo` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+			},
+			"password_wo": {
+				Description: "The password for the user. The value is only sent to nexus on creation or when `password_version` changes and is never stored in the state. Exactly one of `password` or `password_wo` must be set.",
+				// Changes of the value itself never produce a diff, rotation is triggered by password_version
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool { return true },
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+			},
+			"password_version": {
+				Description:  "Change this value to apply the current `password_wo` to the user.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"roles": {
 				Description: "The roles which the user has been assigned within Nexus.",
@@ -57,6 +73,11 @@ func ResourceSecurityUser() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeSet,
 			},
+			"source": {
+				Description: "The user source which is the origin of this user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"status": {
 				Default:     "active",
 				Description: "The user's status, e.g. active or disabled.",
@@ -77,12 +98,30 @@ func getSecurityUserFromResourceData(d *schema.ResourceData) security.User {
 		FirstName:    d.Get("firstname").(string),
 		LastName:     d.Get("lastname").(string),
 		EmailAddress: d.Get("email").(string),
-		Password:     d.Get("password").(string),
+		Password:     getSecurityUserPassword(d),
 		Status:       d.Get("status").(string),
 		Roles:        tools.InterfaceSliceToStringSlice(d.Get("roles").(*schema.Set).List()),
 	}
 }
 
+// getSecurityUserPassword returns the password, password_wo is read from the
+// raw config as its value is never part of the plan
+func getSecurityUserPassword(d *schema.ResourceData) string {
+	if password := d.Get("password").(string); password != "" {
+		return password
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return ""
+	}
+	passwordWO := config.GetAttr("password_wo")
+	if passwordWO.IsNull() || !passwordWO.IsKnown() {
+		return ""
+	}
+	return passwordWO.AsString()
+}
+
 func resourceSecurityUserCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 	user := getSecurityUserFromResourceData(d)
@@ -112,6 +151,7 @@ func resourceSecurityUserRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("firstname", user.FirstName)
 	d.Set("lastname", user.LastName)
 	d.Set("roles", tools.StringSliceToInterfaceSlice(user.Roles))
+	d.Set("source", user.Source)
 	d.Set("status", user.Status)
 	d.Set("userid", user.UserID)
 
@@ -121,9 +161,8 @@ func resourceSecurityUserRead(d *schema.ResourceData, m interface{}) error {
 func resourceSecurityUserUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	if d.HasChange("password") {
-		password := d.Get("password").(string)
-		if err := client.Security.User.ChangePassword(d.Id(), password); err != nil {
+	if (d.HasChange("password") && d.Get("password").(string) != "") || d.HasChange("password_version") {
+		if err := client.Security.User.ChangePassword(d.Id(), getSecurityUserPassword(d)); err != nil {
 			return err
 		}
 	}
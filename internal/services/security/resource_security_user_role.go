@@ -0,0 +1,213 @@
+package security
+
+import (
+	"context"
+	"fmt"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/datadrivers/terraform-provider-nexus/internal/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	userRoleModeAdditive      = "additive"
+	userRoleModeAuthoritative = "authoritative"
+)
+
+func ResourceSecurityUserRole() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to assign roles to an existing user. Users of external sources like LDAP, SAML or Crowd are supported by setting ` + "`source`" + `, their roles are stored as role mappings in Nexus.
+
+In ` + "`additive`" + ` mode only the given roles are managed, other roles of the user are left untouched. In ` + "`authoritative`" + ` mode the resource owns the complete role list and removes roles assigned outside of Terraform.
+
+~> The user must not be managed with ` + "`roles`" + ` of a ` + "`nexus_security_user`" + ` resource at the same time, otherwise both resources remove the roles of each other.`,
+
+		CustomizeDiff: validateRoles,
+		Create:        resourceSecurityUserRoleCreate,
+		Read:          resourceSecurityUserRoleRead,
+		Update:        resourceSecurityUserRoleUpdate,
+		Delete:        resourceSecurityUserRoleDelete,
+		Exists:        resourceSecurityUserRoleExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSecurityUserRoleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"userid": {
+				Description: "The userid of the user",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"roles": {
+				Description: "The roles which are assigned to the user",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+				Type:     schema.TypeSet,
+			},
+			"source": {
+				Default:     userSourceDefault,
+				Description: "The user source of the user, e.g. `default`, `LDAP`, `Crowd` or `SAML`. Default: \"default\"",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"mode": {
+				Default:     userRoleModeAdditive,
+				Description: "Either `additive` to only manage the given roles or `authoritative` to own the complete role list of the user. Default: \"additive\"",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					userRoleModeAdditive,
+					userRoleModeAuthoritative,
+				}, false),
+			},
+		},
+	}
+}
+
+// updateSecurityUserRoles adds and removes roles of a user. With authoritative
+// set, the roles of the user are replaced by add.
+func updateSecurityUserRoles(m interface{}, userID string, source string, add []string, remove []string, authoritative bool) error {
+	unlock := lockSecurityUser(userID, source)
+	defer unlock()
+
+	client := m.(*nexus.NexusClient)
+
+	user, err := getSecurityUserOfSource(m, userID, source)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return fmt.Errorf("user '%s' of source '%s' does not exist", userID, source)
+	}
+
+	removed := map[string]bool{}
+	for _, role := range remove {
+		removed[role] = true
+	}
+
+	newRoles := []string{}
+	existing := map[string]bool{}
+	if !authoritative {
+		for _, role := range user.Roles {
+			if !removed[role] {
+				newRoles = append(newRoles, role)
+				existing[role] = true
+			}
+		}
+	}
+	for _, role := range add {
+		if !existing[role] {
+			newRoles = append(newRoles, role)
+			existing[role] = true
+		}
+	}
+
+	user.Roles = newRoles
+	return client.Security.User.Update(userID, *user)
+}
+
+func resourceSecurityUserRoleCreate(d *schema.ResourceData, m interface{}) error {
+	userID := d.Get("userid").(string)
+	source := d.Get("source").(string)
+	roles := tools.InterfaceSliceToStringSlice(d.Get("roles").(*schema.Set).List())
+	authoritative := d.Get("mode").(string) == userRoleModeAuthoritative
+
+	if err := updateSecurityUserRoles(m, userID, source, roles, nil, authoritative); err != nil {
+		return err
+	}
+
+	d.SetId(securityUserSourceID(userID, source))
+	return resourceSecurityUserRoleRead(d, m)
+}
+
+func resourceSecurityUserRoleRead(d *schema.ResourceData, m interface{}) error {
+	userID, source := parseSecurityUserSourceID(d.Id())
+
+	user, err := getSecurityUserOfSource(m, userID, source)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		d.SetId("")
+		return nil
+	}
+
+	roles := user.Roles
+	if d.Get("mode").(string) != userRoleModeAuthoritative {
+		// Only report managed roles
+		managed := d.Get("roles").(*schema.Set)
+		roles = []string{}
+		for _, role := range user.Roles {
+			if managed.Contains(role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	d.Set("userid", user.UserID)
+	d.Set("source", source)
+	d.Set("roles", tools.StringSliceToInterfaceSlice(roles))
+
+	return nil
+}
+
+func resourceSecurityUserRoleUpdate(d *schema.ResourceData, m interface{}) error {
+	userID, source := parseSecurityUserSourceID(d.Id())
+
+	if d.HasChange("roles") || d.HasChange("mode") {
+		oldRoles, newRoles := d.GetChange("roles")
+		add := tools.InterfaceSliceToStringSlice(newRoles.(*schema.Set).List())
+		remove := tools.InterfaceSliceToStringSlice(oldRoles.(*schema.Set).Difference(newRoles.(*schema.Set)).List())
+		authoritative := d.Get("mode").(string) == userRoleModeAuthoritative
+
+		if err := updateSecurityUserRoles(m, userID, source, add, remove, authoritative); err != nil {
+			return err
+		}
+	}
+
+	return resourceSecurityUserRoleRead(d, m)
+}
+
+func resourceSecurityUserRoleDelete(d *schema.ResourceData, m interface{}) error {
+	userID, source := parseSecurityUserSourceID(d.Id())
+	roles := tools.InterfaceSliceToStringSlice(d.Get("roles").(*schema.Set).List())
+
+	if err := updateSecurityUserRoles(m, userID, source, nil, roles, false); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceSecurityUserRoleImport adopts all current roles of the user
+func resourceSecurityUserRoleImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	userID, source := parseSecurityUserSourceID(d.Id())
+
+	user, err := getSecurityUserOfSource(m, userID, source)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user '%s' of source '%s' does not exist", userID, source)
+	}
+
+	d.Set("mode", userRoleModeAdditive)
+	d.Set("roles", tools.StringSliceToInterfaceSlice(user.Roles))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceSecurityUserRoleExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	userID, source := parseSecurityUserSourceID(d.Id())
+
+	user, err := getSecurityUserOfSource(m, userID, source)
+	return user != nil, err
+}
@@ -0,0 +1,81 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceSecurityUserRoleConfig(user security.User, mode string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_user" "acceptance" {
+	userid    = "%s"
+	firstname = "%s"
+	lastname  = "%s"
+	email     = "%s"
+	password  = "%s"
+	status    = "active"
+	roles     = ["nx-anonymous"]
+
+	# Roles are contributed by nexus_security_user_role
+	lifecycle {
+		ignore_changes = [roles]
+	}
+}
+
+resource "nexus_security_user_role" "acceptance" {
+	userid = nexus_security_user.acceptance.userid
+	roles  = ["nx-admin"]
+	mode   = "%s"
+}
+
+data "nexus_security_user" "acceptance" {
+	userid = nexus_security_user_role.acceptance.userid
+}
+`, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password, mode)
+}
+
+func TestAccResourceSecurityUserRole(t *testing.T) {
+	resName := "nexus_security_user_role.acceptance"
+	dataSourceName := "data.nexus_security_user.acceptance"
+	user := testAccResourceSecurityUser()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserRoleConfig(user, "additive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+					resource.TestCheckResourceAttr(resName, "userid", user.UserID),
+					resource.TestCheckResourceAttr(resName, "source", "default"),
+					resource.TestCheckResourceAttr(resName, "mode", "additive"),
+					resource.TestCheckResourceAttr(resName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "roles.*", "nx-admin"),
+					// The role assigned outside of the resource is kept
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserRoleConfig(user, "authoritative"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "mode", "authoritative"),
+					resource.TestCheckResourceAttr(resName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resName, "roles.*", "nx-admin"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportStateId:           user.UserID,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mode"},
+			},
+		},
+	})
+}
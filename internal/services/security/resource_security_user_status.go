@@ -0,0 +1,117 @@
+package security
+
+import (
+	"fmt"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/terraform-provider-nexus/internal/schema/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSecurityUserStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: `Use this resource to manage only the status of an existing user, e.g. to disable accounts which are otherwise managed outside of Terraform.
+
+Destroying the resource only removes it from the state, the status of the user is kept.
+
+~> The user must not be managed with ` + "`status`" + ` of a ` + "`nexus_security_user`" + ` resource at the same time.`,
+
+		Create: resourceSecurityUserStatusUpdate,
+		Read:   resourceSecurityUserStatusRead,
+		Update: resourceSecurityUserStatusUpdate,
+		Delete: resourceSecurityUserStatusDelete,
+		Exists: resourceSecurityUserStatusExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": common.ResourceID,
+			"userid": {
+				Description: "The userid of the user",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"source": {
+				Default:     userSourceDefault,
+				Description: "The user source of the user, e.g. `default` or `LDAP`. Default: \"default\"",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description: "The user's status. Possible values: `active`, `disabled` or `locked`",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"active",
+					"disabled",
+					"locked",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceSecurityUserStatusRead(d *schema.ResourceData, m interface{}) error {
+	userID, source := parseSecurityUserSourceID(d.Id())
+
+	user, err := getSecurityUserOfSource(m, userID, source)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("source", source)
+	d.Set("status", user.Status)
+	d.Set("userid", user.UserID)
+
+	return nil
+}
+
+func resourceSecurityUserStatusUpdate(d *schema.ResourceData, m interface{}) error {
+	userID := d.Get("userid").(string)
+	source := d.Get("source").(string)
+
+	if err := updateSecurityUserStatus(m, userID, source, d.Get("status").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(securityUserSourceID(userID, source))
+	return resourceSecurityUserStatusRead(d, m)
+}
+
+func updateSecurityUserStatus(m interface{}, userID string, source string, status string) error {
+	unlock := lockSecurityUser(userID, source)
+	defer unlock()
+
+	client := m.(*nexus.NexusClient)
+
+	user, err := getSecurityUserOfSource(m, userID, source)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return fmt.Errorf("user '%s' of source '%s' does not exist", userID, source)
+	}
+
+	user.Status = status
+	return client.Security.User.Update(userID, *user)
+}
+
+func resourceSecurityUserStatusDelete(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func resourceSecurityUserStatusExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	userID, source := parseSecurityUserSourceID(d.Id())
+
+	user, err := getSecurityUserOfSource(m, userID, source)
+	return user != nil, err
+}
@@ -0,0 +1,137 @@
+package security_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccResourceSecurityUserStatusConfig(user security.User, status string) string {
+	return fmt.Sprintf(`
+resource "nexus_security_user" "acceptance" {
+	userid    = "%s"
+	firstname = "%s"
+	lastname  = "%s"
+	email     = "%s"
+	password  = "%s"
+	status    = "active"
+	roles     = ["nx-anonymous"]
+
+	# The status is managed by nexus_security_user_status
+	lifecycle {
+		ignore_changes = [status]
+	}
+}
+
+resource "nexus_security_user_status" "acceptance" {
+	userid = nexus_security_user.acceptance.userid
+	status = "%s"
+}
+
+data "nexus_security_user" "acceptance" {
+	userid = nexus_security_user_status.acceptance.userid
+}
+`, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password, status)
+}
+
+func TestAccResourceSecurityUserStatus(t *testing.T) {
+	resName := "nexus_security_user_status.acceptance"
+	dataSourceName := "data.nexus_security_user.acceptance"
+	user := testAccResourceSecurityUser()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserStatusConfig(user, "disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+					resource.TestCheckResourceAttr(resName, "userid", user.UserID),
+					resource.TestCheckResourceAttr(resName, "source", "default"),
+					resource.TestCheckResourceAttr(resName, "status", "disabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "disabled"),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserStatusConfig(user, "active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "status", "active"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "active"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportStateId:     user.UserID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSecurityUserStatusWithUserRoleConfig(user security.User) string {
+	return fmt.Sprintf(`
+resource "nexus_security_user" "acceptance" {
+	userid    = "%s"
+	firstname = "%s"
+	lastname  = "%s"
+	email     = "%s"
+	password  = "%s"
+	status    = "active"
+	roles     = ["nx-anonymous"]
+
+	# Roles and status are managed by separate resources
+	lifecycle {
+		ignore_changes = [roles, status]
+	}
+}
+
+# Both resources change the same user in parallel
+resource "nexus_security_user_role" "acceptance" {
+	userid = nexus_security_user.acceptance.userid
+	roles  = ["nx-admin"]
+}
+
+resource "nexus_security_user_status" "acceptance" {
+	userid = nexus_security_user.acceptance.userid
+	status = "disabled"
+}
+
+data "nexus_security_user" "acceptance" {
+	userid = nexus_security_user.acceptance.userid
+
+	depends_on = [
+		nexus_security_user_role.acceptance,
+		nexus_security_user_status.acceptance,
+	]
+}
+`, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password)
+}
+
+func TestAccResourceSecurityUserStatusWithUserRole(t *testing.T) {
+	dataSourceName := "data.nexus_security_user.acceptance"
+	user := testAccResourceSecurityUser()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserStatusWithUserRoleConfig(user),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_security_user_status.acceptance", "status", "disabled"),
+					resource.TestCheckTypeSetElemAttr("nexus_security_user_role.acceptance", "roles.*", "nx-admin"),
+					// Neither resource overwrites the change of the other one
+					resource.TestCheckResourceAttr(dataSourceName, "status", "disabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "roles.*", "nx-admin"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "roles.*", "nx-anonymous"),
+				),
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package security_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/datadrivers/terraform-provider-nexus/internal/acceptance"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func testAccResourceSecurityUser() security.User {
@@ -78,3 +80,110 @@ resource "nexus_security_user" "acceptance" {
 }
 `, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password, user.Status, strings.Join(user.Roles, "\", \""))
 }
+
+func TestAccResourceSecurityUserMissingRole(t *testing.T) {
+	user := testAccResourceSecurityUser()
+	user.Roles = []string{"does-not-exist"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceSecurityUserConfig(user),
+				ExpectError: regexp.MustCompile("roles do not exist: does-not-exist"),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityUserRoleOfSameRun(t *testing.T) {
+	user := testAccResourceSecurityUser()
+	roleID := fmt.Sprintf("role-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// The role does not exist at plan time
+				Config: fmt.Sprintf(`
+resource "nexus_security_role" "acceptance" {
+	roleid = "%s"
+	name   = "%s"
+	roles  = ["nx-anonymous"]
+}
+
+resource "nexus_security_user" "acceptance" {
+	userid    = "%s"
+	firstname = "%s"
+	lastname  = "%s"
+	email     = "%s"
+	password  = "%s"
+	status    = "active"
+	roles     = [nexus_security_role.acceptance.roleid]
+}
+`, roleID, roleID, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password),
+				Check: resource.TestCheckTypeSetElemAttr("nexus_security_user.acceptance", "roles.*", roleID),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecurityUserPasswordWriteOnly(t *testing.T) {
+	resName := "nexus_security_user.acceptance"
+
+	user := testAccResourceSecurityUser()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acceptance.AccPreCheck(t) },
+		Providers: acceptance.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSecurityUserPasswordWriteOnlyConfig(user, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", user.UserID),
+					testAccCheckSecurityUserPasswordNotStored(resName),
+					resource.TestCheckResourceAttr(resName, "password_version", "1"),
+				),
+			},
+			{
+				Config: testAccResourceSecurityUserPasswordWriteOnlyConfig(user, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityUserPasswordNotStored(resName),
+					resource.TestCheckResourceAttr(resName, "password_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityUserPasswordNotStored(resName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resName)
+		}
+		for _, key := range []string{"password", "password_wo"} {
+			if rs.Primary.Attributes[key] != "" {
+				return fmt.Errorf("%s: attribute '%s' must not be stored in state", resName, key)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccResourceSecurityUserPasswordWriteOnlyConfig(user security.User, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "nexus_security_user" "acceptance" {
+	userid           = "%s"
+	firstname        = "%s"
+	lastname         = "%s"
+	email            = "%s"
+	password_wo      = "%s-%d"
+	password_version = %d
+	status           = "%s"
+	roles            = ["%s"]
+}
+`, user.UserID, user.FirstName, user.LastName, user.EmailAddress, user.Password, passwordVersion, passwordVersion, user.Status, strings.Join(user.Roles, "\", \""))
+}
@@ -1,9 +1,14 @@
 package tools
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -68,3 +73,47 @@ func ConvertStringSet(set *schema.Set) []string {
 
 	return s
 }
+
+// HashSensitiveValue can be used as StateFunc of write-only attributes, which
+// are not returned by the Nexus API. Only the hash of the configured value is
+// stored in the state, which still allows detecting changes.
+func HashSensitiveValue(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAttributeConfigured reports whether the attribute addressed by the given
+// flatmap key, e.g. "proxy.0.content_max_age", is set in the raw configuration.
+// Values which are not known yet count as configured.
+func IsAttributeConfigured(config cty.Value, key string) bool {
+	v := config
+	for _, part := range strings.Split(key, ".") {
+		if !v.IsKnown() {
+			return true
+		}
+		if v.IsNull() {
+			return false
+		}
+		t := v.Type()
+		switch {
+		case t.IsObjectType():
+			if !t.HasAttribute(part) {
+				return false
+			}
+			v = v.GetAttr(part)
+		case t.IsListType() || t.IsTupleType():
+			i, err := strconv.Atoi(part)
+			if err != nil || i >= v.LengthInt() {
+				return false
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+		default:
+			return false
+		}
+	}
+	return !v.IsNull()
+}
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
@@ -47,3 +48,34 @@ func TestConvertStringSet(t *testing.T) {
 
 	assert.Equal(t, testStrings, convertedSet)
 }
+
+func TestHashSensitiveValue(t *testing.T) {
+	assert.Equal(t, "", HashSensitiveValue(""))
+	assert.Equal(t, "", HashSensitiveValue(nil))
+	assert.Equal(t, "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", HashSensitiveValue("foo"))
+}
+
+func TestIsAttributeConfigured(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("foo"),
+		"proxy": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"content_max_age":  cty.NumberIntVal(60),
+				"metadata_max_age": cty.NullVal(cty.Number),
+				"remote_url":       cty.UnknownVal(cty.String),
+			}),
+		}),
+		"negative_cache": cty.ListValEmpty(cty.Object(map[string]cty.Type{
+			"ttl": cty.Number,
+		})),
+	})
+
+	assert.True(t, IsAttributeConfigured(config, "name"))
+	assert.True(t, IsAttributeConfigured(config, "proxy.0.content_max_age"))
+	assert.True(t, IsAttributeConfigured(config, "proxy.0.remote_url"))
+	assert.False(t, IsAttributeConfigured(config, "proxy.0.metadata_max_age"))
+	assert.False(t, IsAttributeConfigured(config, "proxy.1.content_max_age"))
+	assert.False(t, IsAttributeConfigured(config, "negative_cache.0.ttl"))
+	assert.False(t, IsAttributeConfigured(config, "unknown"))
+	assert.False(t, IsAttributeConfigured(cty.NullVal(config.Type()), "name"))
+}
@@ -0,0 +1,53 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	PlannedBlobStore = "blobstore"
+	PlannedRole      = "role"
+)
+
+// Plan-time validations check that referenced objects like blob stores exist.
+// Objects created by other resources of the same run do not exist yet, so
+// these resources register the names they are going to create. Terraform
+// plans them before the resources referencing them.
+var planned sync.Map
+
+type plannedObject struct {
+	meta interface{}
+	kind string
+	name string
+}
+
+// RegisterPlanned remembers that a resource of the provider instance meta is
+// going to create the object of the given kind
+func RegisterPlanned(meta interface{}, kind string, name string) {
+	planned.Store(plannedObject{meta: meta, kind: kind, name: strings.ToLower(name)}, true)
+}
+
+// IsPlanned reports whether a resource of the provider instance meta is going
+// to create the object of the given kind
+func IsPlanned(meta interface{}, kind string, name string) bool {
+	_, ok := planned.Load(plannedObject{meta: meta, kind: kind, name: strings.ToLower(name)})
+	return ok
+}
+
+// RegisterPlannedDiff returns a CustomizeDiffFunc which registers the value of
+// attribute as planned object of the given kind when it is created or renamed
+func RegisterPlannedDiff(kind string, attribute string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if (diff.Id() != "" && !diff.HasChange(attribute)) || !diff.NewValueKnown(attribute) {
+			return nil
+		}
+		if name := diff.Get(attribute).(string); name != "" {
+			RegisterPlanned(meta, kind, name)
+		}
+		return nil
+	}
+}
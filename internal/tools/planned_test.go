@@ -0,0 +1,20 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPlanned(t *testing.T) {
+	meta := &struct{ name string }{"provider"}
+	otherMeta := &struct{ name string }{"other"}
+
+	assert.False(t, IsPlanned(meta, PlannedBlobStore, "team"))
+
+	RegisterPlanned(meta, PlannedBlobStore, "Team")
+	assert.True(t, IsPlanned(meta, PlannedBlobStore, "team"))
+	assert.True(t, IsPlanned(meta, PlannedBlobStore, "TEAM"))
+	assert.False(t, IsPlanned(meta, PlannedRole, "team"))
+	assert.False(t, IsPlanned(otherMeta, PlannedBlobStore, "team"))
+}